@@ -0,0 +1,337 @@
+package gosc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EqualOrdered returns true if the slices contain the same items in the same order
+func EqualOrdered[T comparable](a, b []T) bool {
+	return EqualBy(a, b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// EqualUnordered returns true if the slices contain the same items the same number of times, in any order
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	count := Frequencies(a)
+	for _, v := range b {
+		if count[v] == 0 {
+			return false
+		}
+		count[v]--
+	}
+
+	return true
+}
+
+// EqualBy returns true if the slices have the same length and the function eq returns true for every couple of items
+// at the same index
+func EqualBy[T, U any](a []T, b []U, eq func(T, U) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IndexedValue is an item of a slice with its index
+type IndexedValue[T any] struct {
+	Index int
+	Value T
+}
+
+// Change is an item of a slice that has a different value at the same index
+type Change[T any] struct {
+	Index int
+	Old   T
+	New   T
+}
+
+// SliceDiffResult is the result of SliceDiff
+type SliceDiffResult[T any] struct {
+	// Added contains the items of the new slice after the end of the old one
+	Added []IndexedValue[T]
+	// Removed contains the items of the old slice after the end of the new one
+	Removed []IndexedValue[T]
+	// Changed contains the items with different values at the same index
+	Changed []Change[T]
+}
+
+// SliceDiff compares two slices index by index, reporting the changed, added and removed items
+func SliceDiff[T comparable](old, new []T) SliceDiffResult[T] {
+	d := SliceDiffResult[T]{
+		Added:   []IndexedValue[T]{},
+		Removed: []IndexedValue[T]{},
+		Changed: []Change[T]{},
+	}
+
+	for i := 0; i < max(len(old), len(new)); i++ {
+		switch {
+		case i >= len(old):
+			d.Added = append(d.Added, IndexedValue[T]{i, new[i]})
+		case i >= len(new):
+			d.Removed = append(d.Removed, IndexedValue[T]{i, old[i]})
+		case old[i] != new[i]:
+			d.Changed = append(d.Changed, Change[T]{i, old[i], new[i]})
+		}
+	}
+
+	return d
+}
+
+// Equal returns true if no difference was found
+func (d SliceDiffResult[T]) Equal() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String returns the differences one per line, to be printed in test failures:
+// "~ [i] old -> new" for the changed items, "+ [i] value" for the added ones and "- [i] value" for the removed ones
+func (d SliceDiffResult[T]) String() string {
+	var sb strings.Builder
+	for _, c := range d.Changed {
+		fmt.Fprintf(&sb, "~ [%d] %v -> %v\n", c.Index, c.Old, c.New)
+	}
+	for _, a := range d.Added {
+		fmt.Fprintf(&sb, "+ [%d] %v\n", a.Index, a.Value)
+	}
+	for _, r := range d.Removed {
+		fmt.Fprintf(&sb, "- [%d] %v\n", r.Index, r.Value)
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// EditOp is the operation of an Edit
+type EditOp int
+
+// Edit operations
+const (
+	EditKeep EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+// String returns the symbol of the operation used in unified diffs
+func (op EditOp) String() string {
+	switch op {
+	case EditDelete:
+		return "-"
+	case EditInsert:
+		return "+"
+	}
+
+	return " "
+}
+
+// Edit is a step of an edit script: an item kept, deleted from the old slice or inserted from the new one.
+// OldIndex is -1 for insertions and NewIndex is -1 for deletions.
+type Edit[T any] struct {
+	Op       EditOp
+	Value    T
+	OldIndex int
+	NewIndex int
+}
+
+// Diff returns the minimal edit script transforming the old slice into the new one,
+// using the linear space variant of the Myers algorithm
+func Diff[T comparable](old, new []T) []Edit[T] {
+	size := (len(old)+len(new)+1)/2 + 2
+	d := &differ[T]{
+		old:    old,
+		new:    new,
+		vf:     make([]int, 2*size),
+		vb:     make([]int, 2*size),
+		offset: size,
+		edits:  make([]Edit[T], 0, max(len(old), len(new))),
+	}
+	d.compare(0, len(old), 0, len(new))
+
+	return d.edits
+}
+
+// differ holds the state of a Diff: the furthest points reached on every diagonal
+// by the forward and backward searches, shared by all the sub-problems
+type differ[T comparable] struct {
+	old, new []T
+	vf, vb   []int
+	offset   int
+	edits    []Edit[T]
+}
+
+// compare appends the edit script transforming old[a0:a1] into new[b0:b1]
+func (d *differ[T]) compare(a0, a1, b0, b1 int) {
+	for a0 < a1 && b0 < b1 && d.old[a0] == d.new[b0] {
+		d.edits = append(d.edits, Edit[T]{EditKeep, d.old[a0], a0, b0})
+		a0, b0 = a0+1, b0+1
+	}
+	suffix := 0
+	for a1 > a0 && b1 > b0 && d.old[a1-1] == d.new[b1-1] {
+		a1, b1, suffix = a1-1, b1-1, suffix+1
+	}
+
+	switch {
+	case a0 == a1:
+		for y := b0; y < b1; y++ {
+			d.edits = append(d.edits, Edit[T]{EditInsert, d.new[y], -1, y})
+		}
+	case b0 == b1:
+		for x := a0; x < a1; x++ {
+			d.edits = append(d.edits, Edit[T]{EditDelete, d.old[x], x, -1})
+		}
+	default:
+		x, y := d.middleSnake(a0, a1, b0, b1)
+		d.compare(a0, x, b0, y)
+		d.compare(x, a1, y, b1)
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.edits = append(d.edits, Edit[T]{EditKeep, d.old[a1+i], a1 + i, b1 + i})
+	}
+}
+
+// middleSnake runs the forward and backward searches on old[a0:a1] and new[b0:b1] until they overlap,
+// and returns a point of a shortest path splitting it into two smaller problems.
+// Both ranges must be non-empty and differ on their first and last items.
+func (d *differ[T]) middleSnake(a0, a1, b0, b1 int) (int, int) {
+	n, m := a1-a0, b1-b0
+	delta := n - m
+	odd := delta%2 != 0
+	vf, vb, off := d.vf, d.vb, d.offset
+	vf[off+1], vb[off+1] = 0, 0
+
+	// The searches always overlap before D exceeds (n+m+1)/2
+	for D := 0; ; D++ {
+		for k := -D; k <= D; k += 2 {
+			var x int
+			if k == -D || (k != D && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.old[a0+x] == d.new[b0+y] {
+				x, y = x+1, y+1
+			}
+			vf[off+k] = x
+
+			if odd && k >= delta-(D-1) && k <= delta+(D-1) && x+vb[off+delta-k] >= n {
+				return a0 + x, b0 + y
+			}
+		}
+
+		// The backward search runs on the reversed slices, where its diagonal k is the forward diagonal delta-k
+		for k := -D; k <= D; k += 2 {
+			var x int
+			if k == -D || (k != D && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.old[a1-1-x] == d.new[b1-1-y] {
+				x, y = x+1, y+1
+			}
+			vb[off+k] = x
+
+			if !odd && delta-k >= -D && delta-k <= D && x+vf[off+delta-k] >= n {
+				return a1 - x, b1 - y
+			}
+		}
+	}
+}
+
+// UnifiedDiff returns the line by line differences between two texts in the unified diff format,
+// with the given number of context lines around every change. An empty string is returned if the texts are equal.
+// A missing line break at the end of a text is reported with the "\ No newline at end of file" marker.
+func UnifiedDiff(oldName, newName, old, new string, context int) string {
+	edits := Diff(splitLines(old), splitLines(new))
+	context = max(context, 0)
+
+	var sb strings.Builder
+	oldLine, newLine := 0, 0 // lines consumed before edits[i]
+	for i := 0; i < len(edits); {
+		if edits[i].Op == EditKeep {
+			oldLine, newLine = oldLine+1, newLine+1
+			i++
+			continue
+		}
+
+		// Extend the hunk until the gap between two changes is larger than the context on both sides
+		end := i
+		for j := i; j < len(edits); j++ {
+			if edits[j].Op == EditKeep {
+				continue
+			}
+			if j-end-1 > 2*context {
+				break
+			}
+			end = j
+		}
+
+		start := max(i-context, 0)
+		oldLine, newLine = oldLine-(i-start), newLine-(i-start)
+		stop := min(end+context+1, len(edits))
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+		}
+
+		var oldCount, newCount int
+		for _, e := range edits[start:stop] {
+			if e.Op != EditInsert {
+				oldCount++
+			}
+			if e.Op != EditDelete {
+				newCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(oldLine, oldCount), hunkRange(newLine, newCount))
+
+		for _, e := range edits[start:stop] {
+			sb.WriteString(e.Op.String() + e.Value)
+			if !strings.HasSuffix(e.Value, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+
+		oldLine += oldCount
+		newLine += newCount
+		i = stop
+	}
+
+	return sb.String()
+}
+
+// hunkRange formats the range of a unified diff hunk, given the lines before it and its length
+func hunkRange(before, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", before)
+	case 1:
+		return fmt.Sprintf("%d", before+1)
+	}
+
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+// splitLines splits a text in lines, keeping their line break so that
+// a last line without it differs from the same line followed by one
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
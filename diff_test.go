@@ -0,0 +1,253 @@
+package gosc
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestEqual tests the EqualOrdered and EqualUnordered functions
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a                 []string
+		b                 []string
+		expectedOrdered   bool
+		expectedUnordered bool
+	}{
+		{[]string{"foo", "bar"}, []string{"foo", "bar"}, true, true},
+		{[]string{"foo", "bar"}, []string{"bar", "foo"}, false, true},
+		{[]string{"foo", "foo", "bar"}, []string{"foo", "bar", "bar"}, false, false},
+		{[]string{"foo", "bar"}, []string{"foo"}, false, false},
+		{[]string{}, []string{}, true, true},
+		{nil, []string{}, true, true},
+	}
+
+	for _, test := range tests {
+		if actual := EqualOrdered(test.a, test.b); actual != test.expectedOrdered {
+			t.Errorf("Expected EqualOrdered(%q, %q) to be %v, got %v", test.a, test.b, test.expectedOrdered, actual)
+		}
+		if actual := EqualUnordered(test.a, test.b); actual != test.expectedUnordered {
+			t.Errorf("Expected EqualUnordered(%q, %q) to be %v, got %v", test.a, test.b, test.expectedUnordered, actual)
+		}
+	}
+}
+
+// TestEqualBy tests the EqualBy function
+func TestEqualBy(t *testing.T) {
+	t.Parallel()
+
+	a := []string{"Foo", "BAR"}
+	if !EqualBy(a, []string{"foo", "bar"}, strings.EqualFold) {
+		t.Errorf("Expected EqualBy(%q, [foo bar], EqualFold) to be true", a)
+	}
+	if EqualBy(a, []string{"foo", "baz"}, strings.EqualFold) {
+		t.Errorf("Expected EqualBy(%q, [foo baz], EqualFold) to be false", a)
+	}
+
+	lengths := []int{3, 3}
+	if !EqualBy(a, lengths, func(s string, n int) bool { return len(s) == n }) {
+		t.Errorf("Expected EqualBy(%q, %v, fn) to be true", a, lengths)
+	}
+}
+
+// TestEqSlicesTypes tests that EqSlices detects slices of different types
+func TestEqSlicesTypes(t *testing.T) {
+	t.Parallel()
+
+	a := []int{}
+	b := []string{}
+	if EqSlices(&a, &b) {
+		t.Errorf("Expected EqSlices(%v, %q) to be false", a, b)
+	}
+}
+
+// TestSliceDiff tests the SliceDiff function
+func TestSliceDiff(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		old      []string
+		new      []string
+		expected SliceDiffResult[string]
+		str      string
+	}{
+		{
+			[]string{"foo", "bar", "baz"}, []string{"foo", "dog", "baz", "cat"},
+			SliceDiffResult[string]{
+				Added:   []IndexedValue[string]{{3, "cat"}},
+				Removed: []IndexedValue[string]{},
+				Changed: []Change[string]{{1, "bar", "dog"}},
+			},
+			"~ [1] bar -> dog\n+ [3] cat",
+		},
+		{
+			[]string{"foo", "bar"}, []string{"foo"},
+			SliceDiffResult[string]{
+				Added:   []IndexedValue[string]{},
+				Removed: []IndexedValue[string]{{1, "bar"}},
+				Changed: []Change[string]{},
+			},
+			"- [1] bar",
+		},
+		{
+			[]string{"foo"}, []string{"foo"},
+			SliceDiffResult[string]{
+				Added:   []IndexedValue[string]{},
+				Removed: []IndexedValue[string]{},
+				Changed: []Change[string]{},
+			},
+			"",
+		},
+	}
+
+	for _, test := range tests {
+		actual := SliceDiff(test.old, test.new)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected SliceDiff(%q, %q) to be %+v, got %+v", test.old, test.new, test.expected, actual)
+		}
+		if actual.String() != test.str {
+			t.Errorf("Expected SliceDiff(%q, %q).String() to be %q, got %q", test.old, test.new, test.str, actual.String())
+		}
+		if actual.Equal() != (test.str == "") {
+			t.Errorf("Expected SliceDiff(%q, %q).Equal() to be %v", test.old, test.new, test.str == "")
+		}
+	}
+}
+
+// TestDiff tests the Diff function
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		old      string
+		new      string
+		expected string
+	}{
+		{"ABCABBA", "CBABAC", "-A+C B-C A B-B A+C"},
+		{"abc", "abc", " a b c"},
+		{"", "abc", "+a+b+c"},
+		{"abc", "", "-a-b-c"},
+		{"", "", ""},
+		{"abcd", "axcyd", " a-b+x c+y d"},
+	}
+
+	for _, test := range tests {
+		edits := Diff(strings.Split(test.old, ""), strings.Split(test.new, ""))
+		actual := ""
+		for _, e := range edits {
+			actual += e.Op.String() + e.Value
+		}
+		if actual != test.expected {
+			t.Errorf("Expected Diff(%q, %q) to be %q, got %q", test.old, test.new, test.expected, actual)
+		}
+	}
+}
+
+// TestDiffMinimal tests that Diff returns a valid and minimal edit script
+func TestDiffMinimal(t *testing.T) {
+	t.Parallel()
+
+	r := NewRandom(9)
+	for n := 0; n < 200; n++ {
+		old := Map(make([]int, r.Intn(12)), func(int) int { return r.Intn(4) })
+		new := Map(make([]int, r.Intn(12)), func(int) int { return r.Intn(4) })
+		edits := Diff(old, new)
+
+		var rebuiltOld, rebuiltNew []int
+		changes := 0
+		for _, e := range edits {
+			if e.Op != EditInsert {
+				rebuiltOld = append(rebuiltOld, e.Value)
+				if old[e.OldIndex] != e.Value {
+					t.Errorf("Expected Diff(%v, %v) OldIndex %d to point to %d", old, new, e.OldIndex, e.Value)
+				}
+			}
+			if e.Op != EditDelete {
+				rebuiltNew = append(rebuiltNew, e.Value)
+				if new[e.NewIndex] != e.Value {
+					t.Errorf("Expected Diff(%v, %v) NewIndex %d to point to %d", old, new, e.NewIndex, e.Value)
+				}
+			}
+			if e.Op != EditKeep {
+				changes++
+			}
+		}
+
+		if !EqualOrdered(rebuiltOld, old) || !EqualOrdered(rebuiltNew, new) {
+			t.Errorf("Expected Diff(%v, %v) to rebuild both slices, got %v and %v", old, new, rebuiltOld, rebuiltNew)
+		}
+		if expected := len(old) + len(new) - 2*lcsLength(old, new); changes != expected {
+			t.Errorf("Expected Diff(%v, %v) to have %d changes, got %d", old, new, expected, changes)
+		}
+	}
+}
+
+// TestDiffLarge tests that Diff runs in linear space on fully different inputs
+// It doesn't run in parallel since TotalAlloc also counts the allocations of the other tests.
+func TestDiffLarge(t *testing.T) {
+	old := Generate(4000, func(i int) int { return i })
+	new := Generate(4000, func(i int) int { return -i - 1 })
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := Diff(old, new)
+	runtime.ReadMemStats(&after)
+
+	if len(edits) != 8000 || CountWhere(edits, func(e Edit[int]) bool { return e.Op == EditKeep }) != 0 {
+		t.Errorf("Expected Diff of fully different slices to delete and insert every item, got %d edits", len(edits))
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 64<<20 {
+		t.Errorf("Expected Diff of 4000 items to allocate less than 64MB, got %dMB", allocated>>20)
+	}
+}
+
+// lcsLength returns the length of the longest common subsequence of two slices
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else {
+				dp[i][j] = max(dp[i-1][j], dp[i][j-1])
+			}
+		}
+	}
+
+	return dp[len(a)][len(b)]
+}
+
+// TestUnifiedDiff tests the UnifiedDiff function
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	old := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	new := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\n"
+
+	var tests = []struct {
+		old      string
+		new      string
+		context  int
+		expected string
+	}{
+		{old, new, 1, "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n@@ -10 +10,2 @@\n j\n+k\n"},
+		{old, new, 4, "--- old\n+++ new\n@@ -1,10 +1,11 @@\n a\n-b\n+B\n c\n d\n e\n f\n g\n h\n i\n j\n+k\n"},
+		{old, new, 0, "--- old\n+++ new\n@@ -2 +2 @@\n-b\n+B\n@@ -10,0 +11 @@\n+k\n"},
+		{"", "foo\n", 3, "--- old\n+++ new\n@@ -0,0 +1 @@\n+foo\n"},
+		{old, old, 3, ""},
+		{"x", "x\n", 3, "--- old\n+++ new\n@@ -1 +1 @@\n-x\n\\ No newline at end of file\n+x\n"},
+		{"a\nb", "a\nc", 3, "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"},
+	}
+
+	for _, test := range tests {
+		if actual := UnifiedDiff("old", "new", test.old, test.new, test.context); actual != test.expected {
+			t.Errorf("Expected UnifiedDiff(%q, %q, %d) to be:\n%s\ngot:\n%s", test.old, test.new, test.context, test.expected, actual)
+		}
+	}
+}
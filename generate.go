@@ -0,0 +1,123 @@
+package gosc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrInvalidStep is returned when a range is requested with a zero or NaN step
+	ErrInvalidStep = errors.New("gosc: step must be non-zero")
+	// ErrInvalidRange is returned when a float range has non-finite bounds or too many items
+	ErrInvalidRange = errors.New("gosc: invalid range")
+)
+
+// maxArangeLen is the maximum number of items returned by Arange
+const maxArangeLen = math.MaxInt32
+
+// Range returns the integers from start (inclusive) to end (exclusive) separated by step,
+// counting down if step is negative. The result is empty if end can't be reached from start in the direction of step.
+// An error wrapping ErrInvalidStep is returned if step is zero.
+func Range[T Integer](start, end, step T) ([]T, error) {
+	var zero T
+	if step == zero {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStep, step)
+	}
+
+	// Compute in uint64 so that the distance and the items never overflow T
+	var dist, abs uint64
+	switch {
+	case step > zero && start < end:
+		dist, abs = uint64(end)-uint64(start), uint64(step)
+	case step < zero && start > end:
+		dist, abs = uint64(start)-uint64(end), -uint64(step)
+	default:
+		return []T{}, nil
+	}
+
+	s := make([]T, (dist-1)/abs+1)
+	for i := range s {
+		s[i] = T(uint64(start) + uint64(i)*uint64(step))
+	}
+
+	return s, nil
+}
+
+// Linspace returns n floats evenly spaced from start to end, both inclusive
+func Linspace[F Float](start, end F, n int) []F {
+	if n <= 0 {
+		return []F{}
+	}
+	if n == 1 {
+		return []F{start}
+	}
+
+	s := make([]F, n)
+	step := (end - start) / F(n-1)
+	for i := range s {
+		s[i] = start + F(i)*step
+	}
+	s[n-1] = end
+
+	return s
+}
+
+// Arange returns the floats from start (inclusive) to end (exclusive) separated by step.
+// Every item is computed as start + i*step so that the rounding errors don't accumulate.
+// An error wrapping ErrInvalidStep is returned if step is zero or NaN, and one wrapping ErrInvalidRange
+// if start or end is not finite or the range would have more than math.MaxInt32 items.
+func Arange[F Float](start, end, step F) ([]F, error) {
+	if step == 0 || step != step {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStep, step)
+	}
+	for _, v := range []float64{float64(start), float64(end)} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%w: bound %v", ErrInvalidRange, v)
+		}
+	}
+
+	n := math.Ceil(float64((end - start) / step))
+	if !(n > 0) {
+		return []F{}, nil
+	}
+	if n > maxArangeLen {
+		return nil, fmt.Errorf("%w: %v items", ErrInvalidRange, n)
+	}
+
+	s := make([]F, int(n))
+	for i := range s {
+		s[i] = start + F(i)*step
+	}
+
+	// The rounding of the count can let end through, which is exclusive
+	for len(s) > 0 && ((step > 0 && s[len(s)-1] >= end) || (step < 0 && s[len(s)-1] <= end)) {
+		s = s[:len(s)-1]
+	}
+
+	return s, nil
+}
+
+// Repeat returns a slice containing n times the value
+func Repeat[T any](v T, n int) []T {
+	return Generate(n, func(int) T {
+		return v
+	})
+}
+
+// Generate returns a slice of n items, the i-th being returned by the function f
+func Generate[T any](n int, f func(i int) T) []T {
+	s := make([]T, max(n, 0))
+	for i := range s {
+		s[i] = f(i)
+	}
+
+	return s
+}
+
+// Fill sets all the items of the slice to the value
+func Fill[T any](s []T, v T) {
+	for i := range s {
+		s[i] = v
+	}
+}
@@ -0,0 +1,150 @@
+package gosc
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// TestRange tests the Range function
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		start, end, step int
+		expected         []int
+	}{
+		{0, 5, 1, []int{0, 1, 2, 3, 4}},
+		{0, 10, 3, []int{0, 3, 6, 9}},
+		{5, 0, -2, []int{5, 3, 1}},
+		{-3, 0, 1, []int{-3, -2, -1}},
+		{0, 5, -1, []int{}},
+		{3, 3, 1, []int{}},
+	}
+
+	for _, test := range tests {
+		actual, err := Range(test.start, test.end, test.step)
+		if err != nil || !EqualOrdered(actual, test.expected) {
+			t.Errorf("Expected Range(%d, %d, %d) to be %v, got %v (%v)", test.start, test.end, test.step, test.expected, actual, err)
+		}
+	}
+
+	// Ranges reaching the bounds of the type must not overflow
+	if actual, _ := Range[int8](0, 127, 100); !EqualOrdered(actual, []int8{0, 100}) {
+		t.Errorf("Expected Range[int8](0, 127, 100) to be [0 100], got %v", actual)
+	}
+	if actual, _ := Range[int8](127, -128, -127); !EqualOrdered(actual, []int8{127, 0, -127}) {
+		t.Errorf("Expected Range[int8](127, -128, -127) to be [127 0 -127], got %v", actual)
+	}
+	if actual, _ := Range[uint8](250, 255, 2); !EqualOrdered(actual, []uint8{250, 252, 254}) {
+		t.Errorf("Expected Range[uint8](250, 255, 2) to be [250 252 254], got %v", actual)
+	}
+	if actual, _ := Range[int8](-128, 127, 1); len(actual) != 255 || actual[254] != 126 {
+		t.Errorf("Expected Range[int8](-128, 127, 1) to have 255 items up to 126, got %v", actual)
+	}
+
+	if _, err := Range(0, 5, 0); !errors.Is(err, ErrInvalidStep) {
+		t.Errorf("Expected Range(0, 5, 0) to return ErrInvalidStep, got %v", err)
+	}
+}
+
+// TestLinspace tests the Linspace function
+func TestLinspace(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		start, end float64
+		n          int
+		expected   []float64
+	}{
+		{0, 1, 5, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{1, -1, 3, []float64{1, 0, -1}},
+		{2, 3, 1, []float64{2}},
+		{2, 3, 0, []float64{}},
+	}
+
+	for _, test := range tests {
+		if actual := Linspace(test.start, test.end, test.n); !EqualOrdered(actual, test.expected) {
+			t.Errorf("Expected Linspace(%v, %v, %d) to be %v, got %v", test.start, test.end, test.n, test.expected, actual)
+		}
+	}
+
+	if actual := Linspace(0.0, 0.3, 4); actual[3] != 0.3 {
+		t.Errorf("Expected Linspace(0, 0.3, 4) to end exactly at 0.3, got %v", actual)
+	}
+}
+
+// TestArange tests the Arange function
+func TestArange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		start, end, step float64
+		expected         []float64
+	}{
+		{0, 1, 0.25, []float64{0, 0.25, 0.5, 0.75}},
+		{1, 0, -0.5, []float64{1, 0.5}},
+		{0, 1, -0.5, []float64{}},
+		{1, 1.3, 0.1, []float64{1, 1.1, 1.2}},
+		{1.3, 1, -0.1, []float64{1.3, 1.2, 1.1}},
+		{1, 2.2, 0.4, []float64{1, 1.4, 1.8}},
+	}
+
+	for _, test := range tests {
+		actual, err := Arange(test.start, test.end, test.step)
+		if err != nil || !EqualOrdered(actual, test.expected) {
+			t.Errorf("Expected Arange(%v, %v, %v) to be %v, got %v (%v)", test.start, test.end, test.step, test.expected, actual, err)
+		}
+	}
+
+	// Accumulating 0.1 a thousand times drifts, multiplying doesn't
+	actual, _ := Arange(0.0, 100, 0.1)
+	if len(actual) != 1000 || actual[999] != 999*0.1 {
+		t.Errorf("Expected Arange(0, 100, 0.1) to have 1000 items up to %v, got %d items up to %v", 999*0.1, len(actual), actual[len(actual)-1])
+	}
+
+	var invalid = []struct {
+		start, end, step float64
+	}{
+		{0, math.Inf(1), 1},
+		{math.NaN(), 1, 1},
+		{0, 1, 1e-300},
+		{-math.MaxFloat64, math.MaxFloat64, 1},
+	}
+
+	for _, test := range invalid {
+		if _, err := Arange(test.start, test.end, test.step); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("Expected Arange(%v, %v, %v) to return ErrInvalidRange, got %v", test.start, test.end, test.step, err)
+		}
+	}
+
+	for _, step := range []float64{0, math.NaN()} {
+		if _, err := Arange(0.0, 1, step); !errors.Is(err, ErrInvalidStep) {
+			t.Errorf("Expected Arange(0, 1, %v) to return ErrInvalidStep, got %v", step, err)
+		}
+	}
+}
+
+// TestRepeat tests the Repeat, Generate and Fill functions
+func TestRepeat(t *testing.T) {
+	t.Parallel()
+
+	if actual := Repeat("foo", 3); !EqualOrdered(actual, []string{"foo", "foo", "foo"}) {
+		t.Errorf("Expected Repeat(foo, 3) to be [foo foo foo], got %q", actual)
+	}
+	if actual := Repeat("foo", -1); len(actual) != 0 {
+		t.Errorf("Expected Repeat(foo, -1) to be empty, got %q", actual)
+	}
+
+	squares := Generate(4, func(i int) int {
+		return i * i
+	})
+	if !EqualOrdered(squares, []int{0, 1, 4, 9}) {
+		t.Errorf("Expected Generate(4, square) to be [0 1 4 9], got %v", squares)
+	}
+
+	Fill(squares, 7)
+	if !EqualOrdered(squares, []int{7, 7, 7, 7}) {
+		t.Errorf("Expected Fill(s, 7) to be [7 7 7 7], got %v", squares)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/danilopolani/gosc
+
+go 1.23
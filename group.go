@@ -0,0 +1,85 @@
+package gosc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey is returned when two items of a slice have the same key
+var ErrDuplicateKey = errors.New("gosc: duplicate key")
+
+// GroupBy groups the items of the slice by the key returned by the function f, preserving their order
+func GroupBy[T any, K comparable](s []T, f func(T) K) map[K][]T {
+	mg := make(map[K][]T)
+	for _, v := range s {
+		k := f(v)
+		mg[k] = append(mg[k], v)
+	}
+
+	return mg
+}
+
+// KeyBy returns a map of the items of the slice by the key returned by the function f.
+// If two items have the same key, the last one wins.
+func KeyBy[T any, K comparable](s []T, f func(T) K) map[K]T {
+	mk := make(map[K]T, len(s))
+	for _, v := range s {
+		mk[f(v)] = v
+	}
+
+	return mk
+}
+
+// KeyByUnique is like KeyBy but returns an error wrapping ErrDuplicateKey if two items have the same key
+func KeyByUnique[T any, K comparable](s []T, f func(T) K) (map[K]T, error) {
+	mk := make(map[K]T, len(s))
+	for _, v := range s {
+		k := f(v)
+		if _, ok := mk[k]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, k)
+		}
+		mk[k] = v
+	}
+
+	return mk, nil
+}
+
+// CountBy returns how many items of the slice have each key returned by the function f
+func CountBy[T any, K comparable](s []T, f func(T) K) map[K]int {
+	mc := make(map[K]int)
+	for _, v := range s {
+		mc[f(v)]++
+	}
+
+	return mc
+}
+
+// Frequencies returns how many times every item occurs in the slice
+func Frequencies[T comparable](s []T) map[T]int {
+	return CountBy(s, identity[T])
+}
+
+// SumBy returns the sum of the values returned by the function f for every item of the slice
+func SumBy[T any, N Number](s []T, f func(T) N) N {
+	var sum N
+	for _, v := range s {
+		sum += f(v)
+	}
+
+	return sum
+}
+
+// AvgBy returns the average of the values returned by the function f for every item of the slice,
+// or 0 if the slice is empty
+func AvgBy[T any, N Number](s []T, f func(T) N) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, v := range s {
+		sum += float64(f(v))
+	}
+
+	return sum / float64(len(s))
+}
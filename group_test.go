@@ -0,0 +1,115 @@
+package gosc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type groupUser struct {
+	id   int
+	role string
+	age  int
+}
+
+var groupUsers = []groupUser{
+	{1, "admin", 30},
+	{2, "user", 20},
+	{3, "user", 25},
+	{1, "guest", 45},
+}
+
+// TestGroupBy tests the GroupBy function
+func TestGroupBy(t *testing.T) {
+	t.Parallel()
+
+	expected := map[string][]groupUser{
+		"admin": {{1, "admin", 30}},
+		"user":  {{2, "user", 20}, {3, "user", 25}},
+		"guest": {{1, "guest", 45}},
+	}
+
+	actual := GroupBy(groupUsers, func(u groupUser) string {
+		return u.role
+	})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected GroupBy(%v, fn) to be %v, got %v", groupUsers, expected, actual)
+	}
+}
+
+// TestKeyBy tests the KeyBy and KeyByUnique functions
+func TestKeyBy(t *testing.T) {
+	t.Parallel()
+
+	byID := func(u groupUser) int {
+		return u.id
+	}
+
+	expected := map[int]groupUser{1: {1, "guest", 45}, 2: {2, "user", 20}, 3: {3, "user", 25}}
+	if actual := KeyBy(groupUsers, byID); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected KeyBy(%v, fn) to be %v, got %v", groupUsers, expected, actual)
+	}
+
+	if _, err := KeyByUnique(groupUsers, byID); !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("Expected KeyByUnique(%v, fn) to return ErrDuplicateKey, got %v", groupUsers, err)
+	}
+
+	actual, err := KeyByUnique(groupUsers[:3], byID)
+	expected = map[int]groupUser{1: {1, "admin", 30}, 2: {2, "user", 20}, 3: {3, "user", 25}}
+	if err != nil || !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected KeyByUnique(%v, fn) to be %v, got %v (%v)", groupUsers[:3], expected, actual, err)
+	}
+}
+
+// TestCountBy tests the CountBy and Frequencies functions
+func TestCountBy(t *testing.T) {
+	t.Parallel()
+
+	expected := map[string]int{"admin": 1, "user": 2, "guest": 1}
+	actual := CountBy(groupUsers, func(u groupUser) string {
+		return u.role
+	})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected CountBy(%v, fn) to be %v, got %v", groupUsers, expected, actual)
+	}
+
+	var tests = []struct {
+		s        []string
+		expected map[string]int
+	}{
+		{[]string{"foo", "bar", "foo"}, map[string]int{"foo": 2, "bar": 1}},
+		{[]string{}, map[string]int{}},
+	}
+
+	for _, test := range tests {
+		if actual := Frequencies(test.s); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected Frequencies(%q) to be %v, got %v", test.s, test.expected, actual)
+		}
+	}
+}
+
+// TestSumAvgBy tests the SumBy and AvgBy functions
+func TestSumAvgBy(t *testing.T) {
+	t.Parallel()
+
+	age := func(u groupUser) int {
+		return u.age
+	}
+
+	if actual := SumBy(groupUsers, age); actual != 120 {
+		t.Errorf("Expected SumBy(%v, fn) to be 120, got %v", groupUsers, actual)
+	}
+	if actual := AvgBy(groupUsers, age); actual != 30 {
+		t.Errorf("Expected AvgBy(%v, fn) to be 30, got %v", groupUsers, actual)
+	}
+	if actual := AvgBy([]groupUser{}, age); actual != 0 {
+		t.Errorf("Expected AvgBy([], fn) to be 0, got %v", actual)
+	}
+
+	half := SumBy([]float64{1.5, 2.5}, func(f float64) float64 {
+		return f / 2
+	})
+	if half != 2 {
+		t.Errorf("Expected SumBy([1.5 2.5], fn) to be 2, got %v", half)
+	}
+}
@@ -0,0 +1,137 @@
+package gosc
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Keys returns a slice containing the keys of the map, in no particular order
+func Keys[K comparable, V any](m map[K]V) []K {
+	ks := make([]K, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+
+	return ks
+}
+
+// SortedKeys returns a slice containing the keys of the map in ascending order
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	ks := Keys(m)
+	slices.Sort(ks)
+	return ks
+}
+
+// Values returns a slice containing the values of the map, in no particular order
+func Values[K comparable, V any](m map[K]V) []V {
+	vs := make([]V, 0, len(m))
+	for _, v := range m {
+		vs = append(vs, v)
+	}
+
+	return vs
+}
+
+// SortedValues returns a slice containing the values of the map in ascending order
+func SortedValues[K comparable, V cmp.Ordered](m map[K]V) []V {
+	vs := Values(m)
+	slices.Sort(vs)
+	return vs
+}
+
+// HasKey returns true if the key exists in the map
+func HasKey[K comparable, V any](m map[K]V, k K) bool {
+	_, ok := m[k]
+	return ok
+}
+
+// KeyExists is an alias of HasKey
+func KeyExists[K comparable, V any](m map[K]V, k K) bool {
+	return HasKey(m, k)
+}
+
+// Invert returns a new map with keys and values swapped.
+// If a value is repeated, the key kept is not predictable.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	mi := make(map[V]K, len(m))
+	for k, v := range m {
+		mi[v] = k
+	}
+
+	return mi
+}
+
+// Merge returns a new map containing the items of all the given maps.
+// When a key is present in more maps the function f is called with the key, the current and the new value
+// and its result is kept; if f is nil the last value wins.
+func Merge[K comparable, V any](f func(K, V, V) V, ms ...map[K]V) map[K]V {
+	mm := make(map[K]V)
+	for _, m := range ms {
+		for k, v := range m {
+			if old, ok := mm[k]; ok && f != nil {
+				v = f(k, old, v)
+			}
+			mm[k] = v
+		}
+	}
+
+	return mm
+}
+
+// Pick returns a new map containing only the given keys
+func Pick[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	mp := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			mp[k] = v
+		}
+	}
+
+	return mp
+}
+
+// Omit returns a new map without the given keys
+func Omit[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	mo := make(map[K]V, len(m))
+	for k, v := range m {
+		mo[k] = v
+	}
+	for _, k := range keys {
+		delete(mo, k)
+	}
+
+	return mo
+}
+
+// MapKeys returns a new map with the keys transformed by the function f.
+// If f returns the same key more than once, the value kept is not predictable.
+func MapKeys[K, L comparable, V any](m map[K]V, f func(K) L) map[L]V {
+	mm := make(map[L]V, len(m))
+	for k, v := range m {
+		mm[f(k)] = v
+	}
+
+	return mm
+}
+
+// MapValues returns a new map with the values transformed by the function f
+func MapValues[K comparable, V, W any](m map[K]V, f func(V) W) map[K]W {
+	mm := make(map[K]W, len(m))
+	for k, v := range m {
+		mm[k] = f(v)
+	}
+
+	return mm
+}
+
+// FilterMap returns a new map containing all the items of the map that satisfy the predicate f
+func FilterMap[K comparable, V any](m map[K]V, f func(K, V) bool) map[K]V {
+	mf := make(map[K]V)
+	for k, v := range m {
+		if f(k, v) {
+			mf[k] = v
+		}
+	}
+
+	return mf
+}
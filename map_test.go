@@ -0,0 +1,153 @@
+package gosc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestKeysValues tests the SortedKeys and SortedValues functions
+func TestKeysValues(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		m              map[string]int
+		expectedKeys   []string
+		expectedValues []int
+	}{
+		{map[string]int{"foo": 3, "bar": 1, "baz": 2}, []string{"bar", "baz", "foo"}, []int{1, 2, 3}},
+		{map[string]int{"foo": 0}, []string{"foo"}, []int{0}},
+		{map[string]int{}, []string{}, []int{}},
+	}
+
+	for _, test := range tests {
+		if actual := SortedKeys(test.m); !EqSlices(&actual, &test.expectedKeys) {
+			t.Errorf("Expected SortedKeys(%v) to be %q, got %q", test.m, test.expectedKeys, actual)
+		}
+		if actual := SortedValues(test.m); !EqSlices(&actual, &test.expectedValues) {
+			t.Errorf("Expected SortedValues(%v) to be %v, got %v", test.m, test.expectedValues, actual)
+		}
+		if actual := Keys(test.m); len(actual) != len(test.m) {
+			t.Errorf("Expected Keys(%v) to have length %d, got %q", test.m, len(test.m), actual)
+		}
+		if actual := Values(test.m); len(actual) != len(test.m) {
+			t.Errorf("Expected Values(%v) to have length %d, got %v", test.m, len(test.m), actual)
+		}
+	}
+}
+
+// TestHasKey tests the HasKey function
+func TestHasKey(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]bool{"foo": false, "bar": true}
+
+	var tests = []struct {
+		key      string
+		expected bool
+	}{
+		{"foo", true},
+		{"bar", true},
+		{"baz", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		if actual := HasKey(m, test.key); actual != test.expected {
+			t.Errorf("Expected HasKey(%v, %q) to be %v, got %v", m, test.key, test.expected, actual)
+		}
+	}
+}
+
+// TestInvert tests the Invert function
+func TestInvert(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"foo": 1, "bar": 2}
+	expected := map[int]string{1: "foo", 2: "bar"}
+
+	if actual := Invert(m); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected Invert(%v) to be %v, got %v", m, expected, actual)
+	}
+}
+
+// TestMerge tests the Merge function
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	a := map[string]int{"foo": 1, "bar": 2}
+	b := map[string]int{"bar": 3, "baz": 4}
+
+	var tests = []struct {
+		f        func(string, int, int) int
+		expected map[string]int
+	}{
+		{nil, map[string]int{"foo": 1, "bar": 3, "baz": 4}},
+		{func(k string, old, new int) int { return old + new }, map[string]int{"foo": 1, "bar": 5, "baz": 4}},
+		{func(k string, old, new int) int { return old }, map[string]int{"foo": 1, "bar": 2, "baz": 4}},
+	}
+
+	for _, test := range tests {
+		if actual := Merge(test.f, a, b); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected Merge(fn, %v, %v) to be %v, got %v", a, b, test.expected, actual)
+		}
+	}
+
+	if a["bar"] != 2 {
+		t.Errorf("Expected Merge to not modify the given maps, got %v", a)
+	}
+}
+
+// TestPickOmit tests the Pick and Omit functions
+func TestPickOmit(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"foo": 1, "bar": 2, "baz": 3}
+
+	var tests = []struct {
+		keys         []string
+		expectedPick map[string]int
+		expectedOmit map[string]int
+	}{
+		{[]string{"foo", "baz"}, map[string]int{"foo": 1, "baz": 3}, map[string]int{"bar": 2}},
+		{[]string{"dog"}, map[string]int{}, map[string]int{"foo": 1, "bar": 2, "baz": 3}},
+		{[]string{}, map[string]int{}, map[string]int{"foo": 1, "bar": 2, "baz": 3}},
+	}
+
+	for _, test := range tests {
+		if actual := Pick(m, test.keys...); !reflect.DeepEqual(actual, test.expectedPick) {
+			t.Errorf("Expected Pick(%v, %q) to be %v, got %v", m, test.keys, test.expectedPick, actual)
+		}
+		if actual := Omit(m, test.keys...); !reflect.DeepEqual(actual, test.expectedOmit) {
+			t.Errorf("Expected Omit(%v, %q) to be %v, got %v", m, test.keys, test.expectedOmit, actual)
+		}
+	}
+}
+
+// TestMapKeysValues tests the MapKeys, MapValues and FilterMap functions
+func TestMapKeysValues(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"foo": 1, "bar": 2, "baz": 3}
+
+	expectedKeys := map[string]int{"FOO": 1, "BAR": 2, "BAZ": 3}
+	if actual := MapKeys(m, strings.ToUpper); !reflect.DeepEqual(actual, expectedKeys) {
+		t.Errorf("Expected MapKeys(%v, fn) to be %v, got %v", m, expectedKeys, actual)
+	}
+
+	expectedValues := map[string]bool{"foo": false, "bar": true, "baz": false}
+	actualValues := MapValues(m, func(i int) bool {
+		return i%2 == 0
+	})
+	if !reflect.DeepEqual(actualValues, expectedValues) {
+		t.Errorf("Expected MapValues(%v, fn) to be %v, got %v", m, expectedValues, actualValues)
+	}
+
+	expectedFilter := map[string]int{"bar": 2, "baz": 3}
+	actualFilter := FilterMap(m, func(k string, v int) bool {
+		return strings.HasPrefix(k, "ba")
+	})
+	if !reflect.DeepEqual(actualFilter, expectedFilter) {
+		t.Errorf("Expected FilterMap(%v, fn) to be %v, got %v", m, expectedFilter, actualFilter)
+	}
+}
@@ -0,0 +1,180 @@
+package gosc
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Min returns the smallest item of the slice, and false if the slice is empty
+func Min[T cmp.Ordered](s []T) (T, bool) {
+	return MinBy(s, identity[T])
+}
+
+// Max returns the largest item of the slice, and false if the slice is empty
+func Max[T cmp.Ordered](s []T) (T, bool) {
+	return MaxBy(s, identity[T])
+}
+
+// MinMax returns the smallest and the largest items of the slice, and false if the slice is empty
+func MinMax[T cmp.Ordered](s []T) (T, T, bool) {
+	var lo, hi T
+	if len(s) == 0 {
+		return lo, hi, false
+	}
+
+	lo, hi = s[0], s[0]
+	for _, v := range s[1:] {
+		if cmp.Less(v, lo) {
+			lo = v
+		}
+		if cmp.Less(hi, v) {
+			hi = v
+		}
+	}
+
+	return lo, hi, true
+}
+
+// MinBy returns the first item of the slice with the smallest key returned by the function f,
+// and false if the slice is empty
+func MinBy[T any, K cmp.Ordered](s []T, f func(T) K) (T, bool) {
+	return bestBy(s, f, func(a, b K) bool {
+		return cmp.Less(a, b)
+	})
+}
+
+// MaxBy returns the first item of the slice with the largest key returned by the function f,
+// and false if the slice is empty
+func MaxBy[T any, K cmp.Ordered](s []T, f func(T) K) (T, bool) {
+	return bestBy(s, f, func(a, b K) bool {
+		return cmp.Less(b, a)
+	})
+}
+
+// bestBy returns the first item whose key is better than all the others according to the function better
+func bestBy[T any, K cmp.Ordered](s []T, f func(T) K, better func(a, b K) bool) (T, bool) {
+	var best T
+	if len(s) == 0 {
+		return best, false
+	}
+
+	best = s[0]
+	bestKey := f(best)
+	for _, v := range s[1:] {
+		if k := f(v); better(k, bestKey) {
+			best, bestKey = v, k
+		}
+	}
+
+	return best, true
+}
+
+// TopK returns the k largest items of the slice in descending order, in O(n log k)
+func TopK[T cmp.Ordered](s []T, k int) []T {
+	return selectK(s, k, func(a, b T) int {
+		return cmp.Compare(b, a)
+	})
+}
+
+// BottomK returns the k smallest items of the slice in ascending order, in O(n log k)
+func BottomK[T cmp.Ordered](s []T, k int) []T {
+	return selectK(s, k, cmp.Compare[T])
+}
+
+// selectK returns the first k items in the order of c, keeping the k best items seen so far
+// in a heap whose root is the worst of them
+func selectK[T any](s []T, k int, c func(a, b T) int) []T {
+	k = max(0, min(k, len(s)))
+	if k == 0 {
+		return []T{}
+	}
+
+	worse := func(a, b T) bool {
+		return c(a, b) > 0
+	}
+
+	h := make([]T, 0, k)
+	for _, v := range s {
+		if len(h) < k {
+			h = append(h, v)
+			heapUp(h, len(h)-1, worse)
+		} else if worse(h[0], v) {
+			h[0] = v
+			heapDown(h, 0, worse)
+		}
+	}
+
+	slices.SortFunc(h, c)
+	return h
+}
+
+// heapUp moves the item at i up to restore the heap property, where the root is the item for which less holds
+func heapUp[T any](h []T, i int, less func(a, b T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(h[i], h[parent]) {
+			return
+		}
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+// heapDown moves the item at i down to restore the heap property
+func heapDown[T any](h []T, i int, less func(a, b T) bool) {
+	for {
+		best := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(h) && less(h[child], h[best]) {
+				best = child
+			}
+		}
+		if best == i {
+			return
+		}
+		h[i], h[best] = h[best], h[i]
+		i = best
+	}
+}
+
+// NthElement rearranges the slice in place so that the item at index n is the one that would be there
+// if the slice was sorted, with smaller or equal items before it and greater or equal items after it, and returns it.
+// It runs in O(n) on average (quickselect). An error wrapping ErrIndexOutOfRange is returned if n is not valid.
+func NthElement[T cmp.Ordered](s []T, n int) (T, error) {
+	if n < 0 || n >= len(s) {
+		var zero T
+		return zero, fmt.Errorf("%w: %d with length %d", ErrIndexOutOfRange, n, len(s))
+	}
+
+	lo, hi := 0, len(s)
+	for hi-lo > 1 {
+		pivot := s[lo+defaultRandom.Intn(hi-lo)]
+
+		// Three-way partition: [lo, lt) < pivot, [lt, gt) == pivot, [gt, hi) > pivot
+		lt, i, gt := lo, lo, hi
+		for i < gt {
+			switch cmp.Compare(s[i], pivot) {
+			case -1:
+				s[lt], s[i] = s[i], s[lt]
+				lt, i = lt+1, i+1
+			case 1:
+				gt--
+				s[gt], s[i] = s[i], s[gt]
+			default:
+				i++
+			}
+		}
+
+		switch {
+		case n < lt:
+			hi = lt
+		case n >= gt:
+			lo = gt
+		default:
+			return s[n], nil
+		}
+	}
+
+	return s[n], nil
+}
@@ -0,0 +1,119 @@
+package gosc
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// TestMinMax tests the Min, Max and MinMax functions
+func TestMinMax(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s           []float64
+		expectedMin float64
+		expectedMax float64
+		expectedOk  bool
+	}{
+		{[]float64{3, -1.5, 7, 2}, -1.5, 7, true},
+		{[]float64{5}, 5, 5, true},
+		{[]float64{}, 0, 0, false},
+	}
+
+	for _, test := range tests {
+		if actual, ok := Min(test.s); actual != test.expectedMin || ok != test.expectedOk {
+			t.Errorf("Expected Min(%v) to be %v %v, got %v %v", test.s, test.expectedMin, test.expectedOk, actual, ok)
+		}
+		if actual, ok := Max(test.s); actual != test.expectedMax || ok != test.expectedOk {
+			t.Errorf("Expected Max(%v) to be %v %v, got %v %v", test.s, test.expectedMax, test.expectedOk, actual, ok)
+		}
+		lo, hi, ok := MinMax(test.s)
+		if lo != test.expectedMin || hi != test.expectedMax || ok != test.expectedOk {
+			t.Errorf("Expected MinMax(%v) to be %v %v %v, got %v %v %v", test.s, test.expectedMin, test.expectedMax, test.expectedOk, lo, hi, ok)
+		}
+	}
+}
+
+// TestMinMaxBy tests the MinBy and MaxBy functions
+func TestMinMaxBy(t *testing.T) {
+	t.Parallel()
+
+	s := []string{"foo", "ab", "bazz", "cd", "dogs"}
+	length := func(s string) int {
+		return len(s)
+	}
+
+	if actual, ok := MinBy(s, length); actual != "ab" || !ok {
+		t.Errorf("Expected MinBy(%q, len) to be ab, got %q %v", s, actual, ok)
+	}
+	if actual, ok := MaxBy(s, length); actual != "bazz" || !ok {
+		t.Errorf("Expected MaxBy(%q, len) to be bazz, got %q %v", s, actual, ok)
+	}
+	if actual, ok := MaxBy([]string{}, length); actual != "" || ok {
+		t.Errorf("Expected MaxBy([], len) to be empty, got %q %v", actual, ok)
+	}
+}
+
+// TestTopK tests the TopK and BottomK functions
+func TestTopK(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s              []int
+		k              int
+		expectedTop    []int
+		expectedBottom []int
+	}{
+		{[]int{5, 1, 9, 3, 7, 9, 2}, 3, []int{9, 9, 7}, []int{1, 2, 3}},
+		{[]int{5, 1}, 5, []int{5, 1}, []int{1, 5}},
+		{[]int{5, 1}, 0, []int{}, []int{}},
+		{[]int{}, 2, []int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		if actual := TopK(test.s, test.k); !EqualOrdered(actual, test.expectedTop) {
+			t.Errorf("Expected TopK(%v, %d) to be %v, got %v", test.s, test.k, test.expectedTop, actual)
+		}
+		if actual := BottomK(test.s, test.k); !EqualOrdered(actual, test.expectedBottom) {
+			t.Errorf("Expected BottomK(%v, %d) to be %v, got %v", test.s, test.k, test.expectedBottom, actual)
+		}
+	}
+
+	// Compare with a full sort on random input
+	r := NewRandom(11)
+	s := Map(make([]int, 500), func(int) int { return r.Intn(100) })
+	sorted := slices.Clone(s)
+	Rsort(&sorted)
+	if actual := TopK(s, 25); !EqualOrdered(actual, sorted[:25]) {
+		t.Errorf("Expected TopK to match the sorted slice, got %v", actual)
+	}
+}
+
+// TestNthElement tests the NthElement function
+func TestNthElement(t *testing.T) {
+	t.Parallel()
+
+	r := NewRandom(13)
+	for round := 0; round < 50; round++ {
+		s := Map(make([]int, r.Intn(30)+1), func(int) int { return r.Intn(10) })
+		sorted := slices.Clone(s)
+		slices.Sort(sorted)
+		n := r.Intn(len(s))
+
+		actual, err := NthElement(s, n)
+		if err != nil || actual != sorted[n] || s[n] != sorted[n] {
+			t.Errorf("Expected NthElement(%v, %d) to be %d, got %d (%v)", s, n, sorted[n], actual, err)
+		}
+		for i, v := range s {
+			if (i < n && v > actual) || (i > n && v < actual) {
+				t.Errorf("Expected NthElement(%v, %d) to partition the slice around %d", s, n, actual)
+				break
+			}
+		}
+	}
+
+	if _, err := NthElement([]int{1, 2}, 2); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected NthElement([1 2], 2) to return ErrIndexOutOfRange, got %v", err)
+	}
+}
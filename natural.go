@@ -0,0 +1,127 @@
+package gosc
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// NaturalOptions configures the natural order of strings
+type NaturalOptions struct {
+	// IgnoreCase compares letters case-insensitively
+	IgnoreCase bool
+	// IgnoreLeadingZeros considers equal numbers with a different amount of leading zeros, such as "7" and "007".
+	// Otherwise the number with less zeros comes first.
+	IgnoreLeadingZeros bool
+}
+
+// Natural returns a Comparator sorting strings in natural ("human") order with the given options:
+// the runs of digits are compared by their numeric value, so "file2" comes before "file10".
+func Natural(opts NaturalOptions) Comparator[string] {
+	return func(a, b string) int {
+		return naturalCompare(a, b, opts)
+	}
+}
+
+// NaturalCompare compares two strings in natural order, returning -1, 0 or +1.
+// It can be used as a Comparator with SortWith.
+func NaturalCompare(a, b string) int {
+	return naturalCompare(a, b, NaturalOptions{})
+}
+
+// NaturalLess returns true if a comes before b in natural order. It can be used with sort.Slice.
+func NaturalLess(a, b string) bool {
+	return NaturalCompare(a, b) < 0
+}
+
+// NaturalSort sorts a slice of strings in place in natural order
+func NaturalSort(s []string) {
+	SortWith(s, NaturalCompare)
+}
+
+// NaturalRsort sorts a slice of strings in place in reverse natural order (desc)
+func NaturalRsort(s []string) {
+	SortWith(s, Comparator[string](NaturalCompare).Desc())
+}
+
+func naturalCompare(a, b string, opts NaturalOptions) int {
+	zeros := 0 // first difference in leading zeros, used only if the strings are otherwise equal
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			ia, ib := digitsEnd(a, i), digitsEnd(b, j)
+			na, nb := trimZeros(a[i:ia]), trimZeros(b[j:ib])
+
+			// A longer number without leading zeros is a greater number
+			if len(na) != len(nb) {
+				return sign(len(na) - len(nb))
+			}
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+			if zeros == 0 {
+				zeros = sign((ia - i) - (ib - j))
+			}
+
+			i, j = ia, ib
+			continue
+		}
+
+		ra, sa := utf8.DecodeRuneInString(a[i:])
+		rb, sb := utf8.DecodeRuneInString(b[j:])
+		if opts.IgnoreCase {
+			ra, rb = unicode.ToLower(ra), unicode.ToLower(rb)
+		}
+		if ra != rb {
+			return sign(int(ra) - int(rb))
+		}
+
+		i += sa
+		j += sb
+	}
+
+	switch {
+	case i < len(a):
+		return 1
+	case j < len(b):
+		return -1
+	case opts.IgnoreLeadingZeros:
+		return 0
+	}
+
+	return zeros
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digitsEnd returns the index after the run of digits starting at i
+func digitsEnd(s string, i int) int {
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+
+	return i
+}
+
+func trimZeros(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+
+	return s
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,90 @@
+package gosc
+
+import (
+	"sort"
+	"testing"
+)
+
+// TestNaturalCompare tests the NaturalCompare function
+func TestNaturalCompare(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file10", "file10", 0},
+		{"file", "file1", -1},
+		{"a1b2", "a1b10", -1},
+		{"a10b2", "a9b10", 1},
+		{"007", "7", 1},
+		{"7", "007", -1},
+		{"x07y2", "x7y1", 1},
+		{"File2", "file1", -1},
+		{"12345678901234567890", "12345678901234567891", -1},
+		{"", "", 0},
+		{"", "a", -1},
+		{"소주2", "소주10", -1},
+	}
+
+	for _, test := range tests {
+		if actual := NaturalCompare(test.a, test.b); actual != test.expected {
+			t.Errorf("Expected NaturalCompare(%q, %q) to be %d, got %d", test.a, test.b, test.expected, actual)
+		}
+		if actual := NaturalLess(test.a, test.b); actual != (test.expected < 0) {
+			t.Errorf("Expected NaturalLess(%q, %q) to be %v, got %v", test.a, test.b, test.expected < 0, actual)
+		}
+	}
+}
+
+// TestNaturalOptions tests the Natural comparator options
+func TestNaturalOptions(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a        string
+		b        string
+		opts     NaturalOptions
+		expected int
+	}{
+		{"File2", "file10", NaturalOptions{IgnoreCase: true}, -1},
+		{"FILE10", "file10", NaturalOptions{IgnoreCase: true}, 0},
+		{"File2", "file10", NaturalOptions{}, -1},
+		{"file10", "File2", NaturalOptions{}, 1},
+		{"007", "7", NaturalOptions{IgnoreLeadingZeros: true}, 0},
+		{"a007b", "a7c", NaturalOptions{IgnoreLeadingZeros: true}, -1},
+	}
+
+	for _, test := range tests {
+		if actual := Natural(test.opts)(test.a, test.b); actual != test.expected {
+			t.Errorf("Expected Natural(%+v)(%q, %q) to be %d, got %d", test.opts, test.a, test.b, test.expected, actual)
+		}
+	}
+}
+
+// TestNaturalSort tests the NaturalSort and NaturalRsort functions
+func TestNaturalSort(t *testing.T) {
+	t.Parallel()
+
+	s := []string{"file10.txt", "file2.txt", "file1.txt", "file20.txt", "file02.txt"}
+
+	NaturalSort(s)
+	if expected := []string{"file1.txt", "file2.txt", "file02.txt", "file10.txt", "file20.txt"}; !EqSlices(&s, &expected) {
+		t.Errorf("Expected NaturalSort to be %q, got %q", expected, s)
+	}
+
+	NaturalRsort(s)
+	if expected := []string{"file20.txt", "file10.txt", "file02.txt", "file2.txt", "file1.txt"}; !EqSlices(&s, &expected) {
+		t.Errorf("Expected NaturalRsort to be %q, got %q", expected, s)
+	}
+
+	sort.Slice(s, func(i, j int) bool {
+		return NaturalLess(s[i], s[j])
+	})
+	if expected := []string{"file1.txt", "file2.txt", "file02.txt", "file10.txt", "file20.txt"}; !EqSlices(&s, &expected) {
+		t.Errorf("Expected sort.Slice with NaturalLess to be %q, got %q", expected, s)
+	}
+}
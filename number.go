@@ -2,11 +2,24 @@ package gosc
 
 import (
 	"fmt"
-	"math/rand"
 	"strconv"
-	"time"
 )
 
+// Integer is a constraint matching all the integer types
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint matching all the floating point types
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint matching all the integer and floating point types
+type Number interface {
+	Integer | Float
+}
+
 // IsInt checks if a string is an integer
 func IsInt(s string) bool {
 	_, err := strconv.Atoi(s)
@@ -26,6 +39,5 @@ func Utoa(u uint) string {
 
 // Rand returns a random int from the given range
 func Rand(min, max int) int {
-	rand.Seed(time.Now().Unix())
-	return rand.Intn(max-min) + min
+	return defaultRandom.Int(min, max)
 }
@@ -0,0 +1,128 @@
+package gosc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// errStopped is the cause of the cancellation of the context passed to the callbacks after an error
+var errStopped = errors.New("gosc: stopped after an error")
+
+// IndexError is an error returned by a callback for the item at Index of a slice
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error returns the error message prefixed by the index
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the original error
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// joinIndexErrors sorts the errors by index and joins them with errors.Join
+func joinIndexErrors(errs []*IndexError) error {
+	slices.SortFunc(errs, func(a, b *IndexError) int {
+		return a.Index - b.Index
+	})
+
+	return errors.Join(Map(errs, func(e *IndexError) error {
+		return e
+	})...)
+}
+
+// ParallelMap returns a new slice containing the results of applying the function f to each item of the slice,
+// running at most limit calls at the same time (GOMAXPROCS if limit is not positive). The order is preserved.
+// On the first error the context passed to f is canceled, no new call is started and the errors of all the
+// failed calls are returned, joined with errors.Join as *IndexError. The context.Canceled errors returned by
+// the calls interrupted by this cancellation are not reported. If ctx is canceled, its error is returned.
+func ParallelMap[T, U any](ctx context.Context, s []T, limit int, f func(context.Context, T) (U, error)) ([]U, error) {
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+	limit = min(limit, len(s))
+
+	wctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	sm := make([]U, len(s))
+	var (
+		next int64
+		mu   sync.Mutex
+		errs []*IndexError
+		wg   sync.WaitGroup
+	)
+
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for wctx.Err() == nil {
+				i := int(atomic.AddInt64(&next, 1) - 1)
+				if i >= len(s) {
+					return
+				}
+
+				v, err := f(wctx, s[i])
+				if err != nil {
+					if errors.Is(err, context.Canceled) && context.Cause(wctx) != nil {
+						return
+					}
+					mu.Lock()
+					errs = append(errs, &IndexError{i, err})
+					mu.Unlock()
+					cancel(errStopped)
+					return
+				}
+				sm[i] = v
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, joinIndexErrors(errs)
+	}
+
+	return sm, nil
+}
+
+// ParallelFilter returns a new slice containing the items of the slice that satisfy the predicate f,
+// running at most limit calls at the same time. The order and the errors are handled like ParallelMap.
+func ParallelFilter[T any](ctx context.Context, s []T, limit int, f func(context.Context, T) (bool, error)) ([]T, error) {
+	keep, err := ParallelMap(ctx, s, limit, f)
+	if err != nil {
+		return nil, err
+	}
+
+	sf := make([]T, 0)
+	for i, v := range s {
+		if keep[i] {
+			sf = append(sf, v)
+		}
+	}
+
+	return sf, nil
+}
+
+// ParallelForEach calls the function f for each item of the slice, running at most limit calls at the same time.
+// The errors are handled like ParallelMap.
+func ParallelForEach[T any](ctx context.Context, s []T, limit int, f func(context.Context, T) error) error {
+	_, err := ParallelMap(ctx, s, limit, func(ctx context.Context, v T) (struct{}, error) {
+		return struct{}{}, f(ctx, v)
+	})
+
+	return err
+}
@@ -0,0 +1,174 @@
+package gosc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestParallelMap tests that ParallelMap preserves the order and respects the limit
+func TestParallelMap(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		n     int
+		limit int
+	}{
+		{50, 4},
+		{3, 0},
+		{3, 10},
+		{0, 2},
+	}
+
+	for _, test := range tests {
+		var active, peak int64
+		s := make([]int, test.n)
+		for i := range s {
+			s[i] = i
+		}
+
+		actual, err := ParallelMap(context.Background(), s, test.limit, func(ctx context.Context, i int) (string, error) {
+			n := atomic.AddInt64(&active, 1)
+			for {
+				p := atomic.LoadInt64(&peak)
+				if n <= p || atomic.CompareAndSwapInt64(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(i%3) * time.Millisecond)
+			atomic.AddInt64(&active, -1)
+			return strconv.Itoa(i), nil
+		})
+
+		expected := Map(s, strconv.Itoa)
+		if err != nil || !EqualOrdered(actual, expected) {
+			t.Errorf("Expected ParallelMap(%v, %d) to be %q, got %q (%v)", s, test.limit, expected, actual, err)
+		}
+		if test.limit > 0 && peak > int64(test.limit) {
+			t.Errorf("Expected ParallelMap(%v, %d) to run at most %d calls at the same time, got %d", s, test.limit, test.limit, peak)
+		}
+	}
+}
+
+// TestParallelMapError tests that ParallelMap stops on the first error
+func TestParallelMapError(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+	s := make([]int, 1000)
+	for i := range s {
+		s[i] = i
+	}
+
+	var calls int64
+	actual, err := ParallelMap(context.Background(), s, 2, func(ctx context.Context, i int) (int, error) {
+		atomic.AddInt64(&calls, 1)
+		if i == 10 {
+			return 0, errBoom
+		}
+		return i, nil
+	})
+
+	var ie *IndexError
+	if actual != nil || !errors.Is(err, errBoom) || !errors.As(err, &ie) || ie.Index != 10 {
+		t.Errorf("Expected ParallelMap to fail at index 10, got %v (%v)", actual, err)
+	}
+	if calls > 20 {
+		t.Errorf("Expected ParallelMap to stop after the first error, got %d calls", calls)
+	}
+}
+
+// TestParallelMapCancel tests that ParallelMap stops when the context is canceled
+func TestParallelMapCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := make([]int, 1000)
+
+	var calls int64
+	_, err := ParallelMap(ctx, s, 2, func(ctx context.Context, i int) (int, error) {
+		if atomic.AddInt64(&calls, 1) == 5 {
+			cancel()
+		}
+		return i, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected ParallelMap to return context.Canceled, got %v", err)
+	}
+	if calls > 10 {
+		t.Errorf("Expected ParallelMap to stop after the cancellation, got %d calls", calls)
+	}
+}
+
+// TestParallelMapBlocking tests that the calls interrupted by a cancellation are not reported as failed
+func TestParallelMapBlocking(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+	s := make([]int, 100)
+	for i := range s {
+		s[i] = i
+	}
+
+	_, err := ParallelMap(context.Background(), s, 8, func(ctx context.Context, i int) (int, error) {
+		if i == 5 {
+			return 0, errBoom
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+
+	if indexes := FailedIndexes(err); !EqualOrdered(indexes, []int{5}) || err.Error() != "index 5: boom" {
+		t.Errorf("Expected ParallelMap to only report index 5, got %v (%v)", indexes, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var started int64
+	_, err = ParallelMap(ctx, s, 8, func(ctx context.Context, i int) (int, error) {
+		if atomic.AddInt64(&started, 1) == 8 {
+			cancel()
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+
+	if err != context.Canceled {
+		t.Errorf("Expected ParallelMap to return the error of the canceled context, got %v", err)
+	}
+}
+
+// TestParallelFilterForEach tests the ParallelFilter and ParallelForEach functions
+func TestParallelFilterForEach(t *testing.T) {
+	t.Parallel()
+
+	s := []string{"foo", "bar", "baz", "dog"}
+	actual, err := ParallelFilter(context.Background(), s, 2, func(ctx context.Context, s string) (bool, error) {
+		return s[0] == 'b', nil
+	})
+	if expected := []string{"bar", "baz"}; err != nil || !EqualOrdered(actual, expected) {
+		t.Errorf("Expected ParallelFilter(%q) to be %q, got %q (%v)", s, expected, actual, err)
+	}
+
+	var total int64
+	err = ParallelForEach(context.Background(), []int64{1, 2, 3}, 3, func(ctx context.Context, i int64) error {
+		atomic.AddInt64(&total, i)
+		return nil
+	})
+	if err != nil || total != 6 {
+		t.Errorf("Expected ParallelForEach to sum 6, got %d (%v)", total, err)
+	}
+
+	err = ParallelForEach(context.Background(), s, 1, func(ctx context.Context, s string) error {
+		if s == "baz" {
+			return errors.New("invalid")
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "index 2: invalid" {
+		t.Errorf("Expected ParallelForEach to return \"index 2: invalid\", got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package gosc
+
+import (
+	cryrand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// defaultRandom is the generator used by Rand, StrRand and the SliceRand functions
+var defaultRandom = NewRandom(cryptoSeed())
+
+// Random is a pseudo random generator safe for concurrent use.
+// It implements rand.Source64, so it can be wrapped with rand.New when a *rand.Rand is needed.
+type Random struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+// NewRandom returns a new Random generator initialized with the given seed.
+// Generators with the same seed return the same sequence of values.
+func NewRandom(seed int64) *Random {
+	return &Random{src: rand.NewSource(seed).(rand.Source64)}
+}
+
+// NewCryptoRandom returns a new Random generator reading from crypto/rand.
+// It can't be seeded and it's slower, but its values are not predictable.
+func NewCryptoRandom() *Random {
+	return &Random{src: cryptoSource{}}
+}
+
+// Int63 returns a non-negative random int64
+func (r *Random) Int63() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.src.Int63()
+}
+
+// Uint64 returns a random uint64
+func (r *Random) Uint64() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.src.Uint64()
+}
+
+// Seed initializes the generator to a deterministic state. It has no effect on crypto generators.
+func (r *Random) Seed(seed int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.src.Seed(seed)
+}
+
+// Rand returns a *rand.Rand backed by the generator, to be used with Shuffle, Sample and the other helpers
+func (r *Random) Rand() *rand.Rand {
+	return rand.New(r)
+}
+
+// Intn returns a random int in [0, n). It returns 0 if n <= 0.
+func (r *Random) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	return int(r.Uint64() % uint64(n))
+}
+
+// Int returns a random int from the given range [min, max). It returns min if max <= min.
+func (r *Random) Int(min, max int) int {
+	if max <= min {
+		return min
+	}
+
+	return r.Intn(max-min) + min
+}
+
+// Float64 returns a random float64 in [0.0, 1.0)
+func (r *Random) Float64() float64 {
+	return float64(r.Uint64()>>11) / (1 << 53)
+}
+
+// String returns a random string of n letters
+func (r *Random) String(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	b := make([]rune, n)
+	for i := range b {
+		b[i] = letterRunes[r.Intn(len(letterRunes))]
+	}
+	return string(b)
+}
+
+// UUID generates a UUID v4 according to RFC 4122 from the generator
+func (r *Random) UUID() string {
+	uuid := make([]byte, 16)
+	binary.BigEndian.PutUint64(uuid[0:8], r.Uint64())
+	binary.BigEndian.PutUint64(uuid[8:16], r.Uint64())
+
+	// variant bits; see section 4.1.1
+	uuid[8] = uuid[8]&^0xc0 | 0x80
+
+	// version 4 (pseudo-random); see section 4.1.3
+	uuid[6] = uuid[6]&^0xf0 | 0x40
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
+
+// RandomPick returns a random item from the slice using the given generator, or the zero value if the slice is empty.
+func RandomPick[T any](r *Random, s []T) T {
+	if len(s) == 0 {
+		var zero T
+		return zero
+	}
+
+	return s[r.Intn(len(s))]
+}
+
+// cryptoSource is a rand.Source64 reading from crypto/rand
+type cryptoSource struct{}
+
+func (cryptoSource) Int63() int64 {
+	return int64(cryptoSource{}.Uint64() &^ (1 << 63))
+}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := cryrand.Read(b[:]); err != nil {
+		panic("gosc: unable to read from crypto/rand: " + err.Error())
+	}
+
+	return binary.BigEndian.Uint64(b[:])
+}
+
+func (cryptoSource) Seed(int64) {}
+
+// cryptoSeed returns a seed read from crypto/rand, falling back to the current time
+func cryptoSeed() int64 {
+	var b [8]byte
+	if _, err := cryrand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+
+	return int64(binary.BigEndian.Uint64(b[:]))
+}
@@ -0,0 +1,107 @@
+package gosc
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+)
+
+// TestRandomSeed tests that two generators with the same seed return the same values
+func TestRandomSeed(t *testing.T) {
+	t.Parallel()
+
+	a := NewRandom(42)
+	b := NewRandom(42)
+
+	for i := 0; i < 10; i++ {
+		if x, y := a.Int(0, 1000), b.Int(0, 1000); x != y {
+			t.Errorf("Expected Int(0, 1000) with the same seed to be equal, got %d and %d", x, y)
+		}
+	}
+
+	if x, y := a.String(12), b.String(12); x != y {
+		t.Errorf("Expected String(12) with the same seed to be equal, got %q and %q", x, y)
+	}
+
+	s := []string{"foo", "bar", "baz", "dog"}
+	if x, y := RandomPick(a, s), RandomPick(b, s); x != y {
+		t.Errorf("Expected RandomPick(%q) with the same seed to be equal, got %q and %q", s, x, y)
+	}
+
+	x := Shuffled(s, a.Rand())
+	y := Shuffled(s, b.Rand())
+	if !EqSlices(&x, &y) {
+		t.Errorf("Expected Shuffled(%q) with the same seed to be equal, got %q and %q", s, x, y)
+	}
+}
+
+// TestRandomInt tests the Int method of Random
+func TestRandomInt(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		min int
+		max int
+	}{
+		{0, 10},
+		{-5, 5},
+		{100, 101},
+		{-10, -3},
+	}
+
+	r := NewRandom(1)
+	for _, test := range tests {
+		for i := 0; i < 100; i++ {
+			actual := r.Int(test.min, test.max)
+			if actual < test.min || actual >= test.max {
+				t.Errorf("Expected Int(%d, %d) to be in range, got %d", test.min, test.max, actual)
+			}
+		}
+	}
+
+	if actual := r.Int(5, 5); actual != 5 {
+		t.Errorf("Expected Int(5, 5) to be 5, got %d", actual)
+	}
+}
+
+// TestRandomStringUUID tests the String and UUID methods of Random
+func TestRandomStringUUID(t *testing.T) {
+	t.Parallel()
+
+	uuidRegexp := regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	for _, r := range []*Random{NewRandom(3), NewCryptoRandom()} {
+		if s := r.String(16); len(s) != 16 || !IsOnlyLetters(s) {
+			t.Errorf("Expected String(16) to be 16 letters, got %q", s)
+		}
+		if s := r.String(0); s != "" {
+			t.Errorf("Expected String(0) to be empty, got %q", s)
+		}
+		if u := r.UUID(); !uuidRegexp.MatchString(u) {
+			t.Errorf("Expected UUID() to be a valid UUID v4, got %q", u)
+		}
+	}
+
+	if v := RandomPick(NewRandom(3), []int{}); v != 0 {
+		t.Errorf("Expected RandomPick([]) to be 0, got %d", v)
+	}
+}
+
+// TestRandomConcurrency tests that Random can be used by many goroutines
+func TestRandomConcurrency(t *testing.T) {
+	t.Parallel()
+
+	r := NewRandom(5)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				r.Intn(10)
+				Rand(0, 10)
+				StrRand(4)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,137 @@
+package seq
+
+import (
+	"iter"
+	"slices"
+)
+
+// Chain wraps an iterator to compose the adapters with method calls, in the style of Lodash _.chain.
+// Nothing is evaluated until a terminal method (Value, First, Reduce, Count) or the iterator returned by Seq is consumed.
+// Methods can't change the item type, use Map with Seq and ChainSeq for that.
+// Unique and UniqueBy, which need comparable items or keys, are functions taking and returning a Chain.
+type Chain[T any] struct {
+	seq iter.Seq[T]
+}
+
+// NewChain returns a Chain over the items of the slice
+func NewChain[T any](s []T) Chain[T] {
+	return Chain[T]{FromSlice(s)}
+}
+
+// ChainSeq returns a Chain over the iterator
+func ChainSeq[T any](seq iter.Seq[T]) Chain[T] {
+	return Chain[T]{seq}
+}
+
+// Map applies the function f to each item
+func (c Chain[T]) Map(f func(T) T) Chain[T] {
+	return Chain[T]{Map(c.seq, f)}
+}
+
+// Filter keeps the items that satisfy the predicate f
+func (c Chain[T]) Filter(f func(T) bool) Chain[T] {
+	return Chain[T]{Filter(c.seq, f)}
+}
+
+// Take keeps the first n items
+func (c Chain[T]) Take(n int) Chain[T] {
+	return Chain[T]{Take(c.seq, n)}
+}
+
+// Skip drops the first n items
+func (c Chain[T]) Skip(n int) Chain[T] {
+	return Chain[T]{Skip(c.seq, n)}
+}
+
+// UniqueBy drops the items of the chain whose key, computed by the function f, has already been seen.
+// It is a function rather than a method because methods can't add the comparable key type.
+func UniqueBy[T any, K comparable](c Chain[T], f func(T) K) Chain[T] {
+	return Chain[T]{func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for v := range c.seq {
+			k := f(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Unique drops the duplicated items of the chain
+func Unique[T comparable](c Chain[T]) Chain[T] {
+	return UniqueBy(c, func(v T) T {
+		return v
+	})
+}
+
+// SortWith sorts the items with the comparison function cmp, which returns a negative number if a comes before b,
+// a positive number if it comes after and 0 if their order doesn't matter. The sort is stable.
+// All the previous items are collected when the first one is consumed.
+func (c Chain[T]) SortWith(cmp func(a, b T) int) Chain[T] {
+	return Chain[T]{func(yield func(T) bool) {
+		s := Collect(c.seq)
+		slices.SortStableFunc(s, cmp)
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Reverse reverses the order of the items.
+// All the previous items are collected when the first one is consumed.
+func (c Chain[T]) Reverse() Chain[T] {
+	return Chain[T]{func(yield func(T) bool) {
+		s := Collect(c.seq)
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(s[i]) {
+				return
+			}
+		}
+	}}
+}
+
+// Seq returns the iterator of the chain
+func (c Chain[T]) Seq() iter.Seq[T] {
+	return c.seq
+}
+
+// Value evaluates the chain and returns its items in a new slice
+func (c Chain[T]) Value() []T {
+	return Collect(c.seq)
+}
+
+// First evaluates the chain until the first item and returns it, or false if there are no items
+func (c Chain[T]) First() (T, bool) {
+	for v := range c.seq {
+		return v, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// Reduce evaluates the chain reducing the items to a single value with the function f, starting from init
+func (c Chain[T]) Reduce(f func(T, T) T, init T) T {
+	acc := init
+	for v := range c.seq {
+		acc = f(acc, v)
+	}
+
+	return acc
+}
+
+// Count evaluates the chain and returns the number of items
+func (c Chain[T]) Count() int {
+	n := 0
+	for range c.seq {
+		n++
+	}
+
+	return n
+}
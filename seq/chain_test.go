@@ -0,0 +1,93 @@
+package seq
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestChain tests a pipeline of chained methods
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	s := []string{"foo", "bar", "baz", "foo", "dog", "bar"}
+
+	actual := Unique(NewChain(s).
+		Map(strings.ToUpper).
+		Filter(func(s string) bool {
+			return strings.HasPrefix(s, "B") || strings.HasPrefix(s, "F")
+		})).
+		SortWith(strings.Compare).
+		Reverse().
+		Take(2).
+		Value()
+
+	if expected := []string{"FOO", "BAZ"}; !slices.Equal(actual, expected) {
+		t.Errorf("Expected chain over %q to be %q, got %q", s, expected, actual)
+	}
+	if s[0] != "foo" {
+		t.Errorf("Expected chain to not modify the slice, got %q", s)
+	}
+}
+
+// TestChainLazy tests that a chain is evaluated only when consumed
+func TestChainLazy(t *testing.T) {
+	t.Parallel()
+
+	produced := 0
+	calls := 0
+	c := ChainSeq(naturals(&produced)).Map(func(i int) int {
+		calls++
+		return i * i
+	})
+
+	if produced != 0 || calls != 0 {
+		t.Errorf("Expected chain to not be evaluated before a terminal method, got %d items", produced)
+	}
+
+	first, ok := c.Filter(func(i int) bool {
+		return i > 10
+	}).First()
+	if !ok || first != 16 || produced != 5 {
+		t.Errorf("Expected First() to be 16 after 5 items, got %d %v after %d items", first, ok, produced)
+	}
+}
+
+// TestChainTerminals tests the terminal methods of Chain
+func TestChainTerminals(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		name string
+		age  int
+	}
+
+	users := []user{{"foo", 30}, {"bar", 20}, {"baz", 30}}
+	c := UniqueBy(NewChain(users), func(u user) int {
+		return u.age
+	}).SortWith(func(a, b user) int {
+		return cmp.Compare(a.age, b.age)
+	})
+
+	if expected := []user{{"bar", 20}, {"foo", 30}}; !slices.Equal(c.Value(), expected) {
+		t.Errorf("Expected UniqueBy(age).SortWith(age) to be %v, got %v", expected, c.Value())
+	}
+	if n := c.Count(); n != 2 {
+		t.Errorf("Expected Count() to be 2, got %d", n)
+	}
+
+	sum := NewChain([]int{1, 2, 3, 4}).Skip(1).Reduce(func(acc, i int) int {
+		return acc + i
+	}, 0)
+	if sum != 9 {
+		t.Errorf("Expected Skip(1).Reduce(sum) to be 9, got %d", sum)
+	}
+
+	if v, ok := NewChain([]int{}).First(); ok || v != 0 {
+		t.Errorf("Expected First() of an empty chain to be 0 false, got %d %v", v, ok)
+	}
+	if n := ChainSeq(Take(NewChain([]int{1, 2, 3}).Seq(), 2)).Count(); n != 2 {
+		t.Errorf("Expected ChainSeq(Take(Seq(), 2)).Count() to be 2, got %d", n)
+	}
+}
@@ -0,0 +1,148 @@
+package seq
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// naturals returns an infinite iterator over the natural numbers, counting how many were produced
+func naturals(produced *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// TestMapFilter tests the Map and Filter functions
+func TestMapFilter(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		expected []string
+	}{
+		{[]int{1, 2, 3, 4}, []string{"2", "4"}},
+		{[]int{1, 3}, []string{}},
+		{[]int{}, []string{}},
+	}
+
+	for _, test := range tests {
+		even := Filter(FromSlice(test.s), func(i int) bool {
+			return i%2 == 0
+		})
+		actual := Collect(Map(even, strconv.Itoa))
+		if !slices.Equal(actual, test.expected) {
+			t.Errorf("Expected Map(Filter(%v)) to be %q, got %q", test.s, test.expected, actual)
+		}
+	}
+}
+
+// TestLazy tests that the adapters consume only the items they need
+func TestLazy(t *testing.T) {
+	t.Parallel()
+
+	produced := 0
+	double := Map(naturals(&produced), func(i int) int {
+		return i * 2
+	})
+	actual := Collect(Take(Filter(double, func(i int) bool {
+		return i%3 == 0
+	}), 3))
+
+	if expected := []int{0, 6, 12}; !slices.Equal(actual, expected) {
+		t.Errorf("Expected Take(Filter(Map(naturals)), 3) to be %v, got %v", expected, actual)
+	}
+	if produced != 7 {
+		t.Errorf("Expected 7 items to be produced, got %d", produced)
+	}
+}
+
+// TestTakeSkip tests the Take, Skip, TakeWhile and SkipWhile functions
+func TestTakeSkip(t *testing.T) {
+	t.Parallel()
+
+	s := []int{1, 2, 3, 4, 1}
+	lessThan3 := func(i int) bool {
+		return i < 3
+	}
+
+	var tests = []struct {
+		name     string
+		seq      iter.Seq[int]
+		expected []int
+	}{
+		{"Take(2)", Take(FromSlice(s), 2), []int{1, 2}},
+		{"Take(10)", Take(FromSlice(s), 10), []int{1, 2, 3, 4, 1}},
+		{"Take(0)", Take(FromSlice(s), 0), []int{}},
+		{"Skip(2)", Skip(FromSlice(s), 2), []int{3, 4, 1}},
+		{"Skip(10)", Skip(FromSlice(s), 10), []int{}},
+		{"TakeWhile", TakeWhile(FromSlice(s), lessThan3), []int{1, 2}},
+		{"SkipWhile", SkipWhile(FromSlice(s), lessThan3), []int{3, 4, 1}},
+	}
+
+	for _, test := range tests {
+		if actual := Collect(test.seq); !slices.Equal(actual, test.expected) {
+			t.Errorf("Expected %s of %v to be %v, got %v", test.name, s, test.expected, actual)
+		}
+	}
+}
+
+// TestChunk tests the Chunk function
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		size     int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{[]int{1, 2}, 2, [][]int{{1, 2}}},
+		{[]int{1, 2}, 0, [][]int{}},
+		{[]int{}, 2, [][]int{}},
+	}
+
+	for _, test := range tests {
+		actual := Collect(Chunk(FromSlice(test.s), test.size))
+		if !slices.EqualFunc(actual, test.expected, slices.Equal) {
+			t.Errorf("Expected Chunk(%v, %d) to be %v, got %v", test.s, test.size, test.expected, actual)
+		}
+	}
+}
+
+// TestZipEnumerate tests the Zip, Enumerate, Keys and Values functions
+func TestZipEnumerate(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"foo", "bar", "baz"}
+	produced := 0
+
+	var actual []string
+	for name, i := range Zip(FromSlice(names), naturals(&produced)) {
+		actual = append(actual, name+strconv.Itoa(i))
+	}
+	if expected := []string{"foo0", "bar1", "baz2"}; !slices.Equal(actual, expected) {
+		t.Errorf("Expected Zip(%q, naturals) to be %q, got %q", names, expected, actual)
+	}
+
+	indexes := Collect(Keys(Enumerate(FromSlice(names))))
+	if expected := []int{0, 1, 2}; !slices.Equal(indexes, expected) {
+		t.Errorf("Expected Keys(Enumerate(%q)) to be %v, got %v", names, expected, indexes)
+	}
+
+	values := Collect(Values(Enumerate(FromSlice(names))))
+	if !slices.Equal(values, names) {
+		t.Errorf("Expected Values(Enumerate(%q)) to be %q, got %q", names, names, values)
+	}
+
+	zipped := Collect(Keys(Zip(FromSlice(names), FromSlice([]int{1}))))
+	if expected := []string{"foo"}; !slices.Equal(zipped, expected) {
+		t.Errorf("Expected Zip to stop at the shortest iterator, got %q", zipped)
+	}
+}
@@ -0,0 +1,371 @@
+package gosc
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// keySet returns a set of the keys computed by the function f on the items of the slice
+func keySet[T any, K comparable](s []T, f func(T) K) map[K]struct{} {
+	ks := make(map[K]struct{}, len(s))
+	for _, v := range s {
+		ks[f(v)] = struct{}{}
+	}
+
+	return ks
+}
+
+// Union returns a new slice containing the unique items of both slices, in the order of first appearance
+func Union[T comparable](a, b []T) []T {
+	return UnionBy(a, b, identity[T])
+}
+
+// UnionBy is like Union but compares the keys returned by the function f
+func UnionBy[T any, K comparable](a, b []T, f func(T) K) []T {
+	su := make([]T, 0, len(a)+len(b))
+	su = append(su, a...)
+	su = append(su, b...)
+	return SliceUniqueBy(su, f)
+}
+
+// Intersection returns a new slice containing the unique items of a that are also in b, in the order of first appearance
+func Intersection[T comparable](a, b []T) []T {
+	return IntersectionBy(a, b, identity[T])
+}
+
+// IntersectionBy is like Intersection but compares the keys returned by the function f
+func IntersectionBy[T any, K comparable](a, b []T, f func(T) K) []T {
+	kb := keySet(b, f)
+	return SliceUniqueBy(Filter(a, func(v T) bool {
+		_, ok := kb[f(v)]
+		return ok
+	}), f)
+}
+
+// Difference returns a new slice containing the unique items of a that are not in b, in the order of first appearance
+func Difference[T comparable](a, b []T) []T {
+	return DifferenceBy(a, b, identity[T])
+}
+
+// DifferenceBy is like Difference but compares the keys returned by the function f
+func DifferenceBy[T any, K comparable](a, b []T, f func(T) K) []T {
+	kb := keySet(b, f)
+	return SliceUniqueBy(Filter(a, func(v T) bool {
+		_, ok := kb[f(v)]
+		return !ok
+	}), f)
+}
+
+// SymmetricDifference returns a new slice containing the unique items that are in only one of the slices:
+// first the ones of a, then the ones of b
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return SymmetricDifferenceBy(a, b, identity[T])
+}
+
+// SymmetricDifferenceBy is like SymmetricDifference but compares the keys returned by the function f
+func SymmetricDifferenceBy[T any, K comparable](a, b []T, f func(T) K) []T {
+	return append(DifferenceBy(a, b, f), DifferenceBy(b, a, f)...)
+}
+
+// IsSubset returns true if all the items of a are also in b
+func IsSubset[T comparable](a, b []T) bool {
+	return IsSubsetBy(a, b, identity[T])
+}
+
+// IsSubsetBy is like IsSubset but compares the keys returned by the function f
+func IsSubsetBy[T any, K comparable](a, b []T, f func(T) K) bool {
+	kb := keySet(b, f)
+	return All(a, func(v T) bool {
+		_, ok := kb[f(v)]
+		return ok
+	})
+}
+
+// Set is an unordered collection of unique items.
+// The zero value is an empty set ready to use; it's not safe for concurrent use, see SyncSet for that.
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+// NewSet returns a new set containing the given items
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{m: make(map[T]struct{}, len(items))}
+	s.Add(items...)
+	return s
+}
+
+// Add adds the given items to the set
+func (s *Set[T]) Add(items ...T) {
+	if s.m == nil {
+		s.m = make(map[T]struct{}, len(items))
+	}
+	for _, v := range items {
+		s.m[v] = struct{}{}
+	}
+}
+
+// Remove removes the given items from the set
+func (s *Set[T]) Remove(items ...T) {
+	for _, v := range items {
+		delete(s.m, v)
+	}
+}
+
+// Has returns true if the item is in the set
+func (s *Set[T]) Has(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+// Len returns the number of items in the set
+func (s *Set[T]) Len() int {
+	return len(s.m)
+}
+
+// Clear removes all the items from the set
+func (s *Set[T]) Clear() {
+	clear(s.m)
+}
+
+// Iter returns an iterator over the items of the set, in no particular order
+func (s *Set[T]) Iter() iter.Seq[T] {
+	return maps.Keys(s.m)
+}
+
+// Slice returns a new slice containing the items of the set, in no particular order.
+// Use SortedSlice to get a deterministic order.
+func (s *Set[T]) Slice() []T {
+	return Keys(s.m)
+}
+
+// Clone returns a copy of the set
+func (s *Set[T]) Clone() *Set[T] {
+	return &Set[T]{m: maps.Clone(s.m)}
+}
+
+// Union returns a new set containing the items of both sets
+func (s *Set[T]) Union(o *Set[T]) *Set[T] {
+	su := s.Clone()
+	if su.m == nil {
+		su.m = make(map[T]struct{}, o.Len())
+	}
+	maps.Copy(su.m, o.m)
+	return su
+}
+
+// Intersection returns a new set containing the items that are in both sets
+func (s *Set[T]) Intersection(o *Set[T]) *Set[T] {
+	si := NewSet[T]()
+	for v := range s.m {
+		if o.Has(v) {
+			si.m[v] = struct{}{}
+		}
+	}
+
+	return si
+}
+
+// Difference returns a new set containing the items that are in s but not in o
+func (s *Set[T]) Difference(o *Set[T]) *Set[T] {
+	sd := NewSet[T]()
+	for v := range s.m {
+		if !o.Has(v) {
+			sd.m[v] = struct{}{}
+		}
+	}
+
+	return sd
+}
+
+// SymmetricDifference returns a new set containing the items that are in only one of the sets
+func (s *Set[T]) SymmetricDifference(o *Set[T]) *Set[T] {
+	sd := s.Difference(o)
+	for v := range o.m {
+		if !s.Has(v) {
+			sd.m[v] = struct{}{}
+		}
+	}
+
+	return sd
+}
+
+// IsSubset returns true if all the items of s are also in o
+func (s *Set[T]) IsSubset(o *Set[T]) bool {
+	for v := range s.m {
+		if !o.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal returns true if both sets contain the same items
+func (s *Set[T]) Equal(o *Set[T]) bool {
+	return s.Len() == o.Len() && s.IsSubset(o)
+}
+
+// MarshalJSON encodes the set as a JSON array, so the same set always produces the same output:
+// the items are sorted by value if their type is ordered (numbers and strings), by their encoding otherwise.
+// It has a value receiver so that sets stored by value in other structs are encoded too.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	type item struct {
+		v T
+		b json.RawMessage
+	}
+
+	items := make([]item, 0, len(s.m))
+	for v := range s.m {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item{v, b})
+	}
+
+	compare := func(a, b item) int {
+		return bytes.Compare(a.b, b.b)
+	}
+	if isOrderedKind(reflect.TypeFor[T]().Kind()) {
+		compare = func(a, b item) int {
+			return compareOrdered(reflect.ValueOf(a.v), reflect.ValueOf(b.v))
+		}
+	}
+	slices.SortFunc(items, compare)
+
+	return json.Marshal(Map(items, func(i item) json.RawMessage {
+		return i.b
+	}))
+}
+
+// isOrderedKind returns true if the kind supports the < operator
+func isOrderedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	}
+
+	return false
+}
+
+// compareOrdered compares two values of the same ordered kind like cmp.Compare
+func compareOrdered(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	}
+
+	return cmp.Compare(a.String(), b.String())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its items
+func (s *Set[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	s.m = make(map[T]struct{}, len(items))
+	s.Add(items...)
+	return nil
+}
+
+// SortedSlice returns a new slice containing the items of the set in ascending order
+func SortedSlice[T cmp.Ordered](s *Set[T]) []T {
+	ss := s.Slice()
+	slices.Sort(ss)
+	return ss
+}
+
+// SyncSet is a Set safe for concurrent use. The zero value is an empty set ready to use.
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// NewSyncSet returns a new concurrency-safe set containing the given items
+func NewSyncSet[T comparable](items ...T) *SyncSet[T] {
+	s := &SyncSet[T]{}
+	s.Add(items...)
+	return s
+}
+
+// Add adds the given items to the set
+func (s *SyncSet[T]) Add(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(items...)
+}
+
+// Remove removes the given items from the set
+func (s *SyncSet[T]) Remove(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Remove(items...)
+}
+
+// Has returns true if the item is in the set
+func (s *SyncSet[T]) Has(v T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(v)
+}
+
+// Len returns the number of items in the set
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Len()
+}
+
+// Clear removes all the items from the set
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+}
+
+// Iter returns an iterator over a snapshot of the items of the set, in no particular order
+func (s *SyncSet[T]) Iter() iter.Seq[T] {
+	return slices.Values(s.Slice())
+}
+
+// Slice returns a new slice containing the items of the set, in no particular order
+func (s *SyncSet[T]) Slice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Slice()
+}
+
+// Snapshot returns a copy of the set as a Set, to be used with the set algebra methods
+func (s *SyncSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+// MarshalJSON encodes the set as a JSON array, see Set.MarshalJSON
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.MarshalJSON()
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its items
+func (s *SyncSet[T]) UnmarshalJSON(b []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.UnmarshalJSON(b)
+}
@@ -0,0 +1,228 @@
+package gosc
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestSetAlgebra tests the Union, Intersection, Difference and SymmetricDifference functions
+func TestSetAlgebra(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a                []string
+		b                []string
+		union            []string
+		intersection     []string
+		difference       []string
+		symmetricDiff    []string
+		expectedIsSubset bool
+	}{
+		{
+			[]string{"foo", "bar", "foo", "baz"}, []string{"baz", "dog", "bar"},
+			[]string{"foo", "bar", "baz", "dog"}, []string{"bar", "baz"}, []string{"foo"}, []string{"foo", "dog"}, false,
+		},
+		{
+			[]string{"foo"}, []string{"bar", "foo"},
+			[]string{"foo", "bar"}, []string{"foo"}, []string{}, []string{"bar"}, true,
+		},
+		{
+			[]string{}, []string{"foo"},
+			[]string{"foo"}, []string{}, []string{}, []string{"foo"}, true,
+		},
+		{
+			[]string{}, []string{},
+			[]string{}, []string{}, []string{}, []string{}, true,
+		},
+	}
+
+	for _, test := range tests {
+		if actual := Union(test.a, test.b); !EqSlices(&actual, &test.union) {
+			t.Errorf("Expected Union(%q, %q) to be %q, got %q", test.a, test.b, test.union, actual)
+		}
+		if actual := Intersection(test.a, test.b); !EqSlices(&actual, &test.intersection) {
+			t.Errorf("Expected Intersection(%q, %q) to be %q, got %q", test.a, test.b, test.intersection, actual)
+		}
+		if actual := Difference(test.a, test.b); !EqSlices(&actual, &test.difference) {
+			t.Errorf("Expected Difference(%q, %q) to be %q, got %q", test.a, test.b, test.difference, actual)
+		}
+		if actual := SymmetricDifference(test.a, test.b); !EqSlices(&actual, &test.symmetricDiff) {
+			t.Errorf("Expected SymmetricDifference(%q, %q) to be %q, got %q", test.a, test.b, test.symmetricDiff, actual)
+		}
+		if actual := IsSubset(test.a, test.b); actual != test.expectedIsSubset {
+			t.Errorf("Expected IsSubset(%q, %q) to be %v, got %v", test.a, test.b, test.expectedIsSubset, actual)
+		}
+	}
+}
+
+// TestSetAlgebraBy tests the By variants of the set functions
+func TestSetAlgebraBy(t *testing.T) {
+	t.Parallel()
+
+	a := []string{"Foo", "bar"}
+	b := []string{"BAR", "baz"}
+
+	var tests = []struct {
+		name     string
+		f        func([]string, []string, func(string) string) []string
+		expected []string
+	}{
+		{"UnionBy", UnionBy[string, string], []string{"Foo", "bar", "baz"}},
+		{"IntersectionBy", IntersectionBy[string, string], []string{"bar"}},
+		{"DifferenceBy", DifferenceBy[string, string], []string{"Foo"}},
+		{"SymmetricDifferenceBy", SymmetricDifferenceBy[string, string], []string{"Foo", "baz"}},
+	}
+
+	for _, test := range tests {
+		if actual := test.f(a, b, strings.ToLower); !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected %s(%q, %q, fn) to be %q, got %q", test.name, a, b, test.expected, actual)
+		}
+	}
+
+	if !IsSubsetBy([]string{"BAZ"}, b, strings.ToLower) {
+		t.Errorf("Expected IsSubsetBy([BAZ], %q, fn) to be true", b)
+	}
+}
+
+// TestSet tests the basic methods of Set
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	var s Set[string]
+	s.Add("foo", "bar", "foo")
+
+	if s.Len() != 2 || !s.Has("foo") || !s.Has("bar") || s.Has("baz") {
+		t.Errorf("Expected set to contain [bar foo], got %q", SortedSlice(&s))
+	}
+
+	s.Remove("foo", "dog")
+	if expected, actual := []string{"bar"}, SortedSlice(&s); !EqSlices(&actual, &expected) {
+		t.Errorf("Expected set to contain %q, got %q", expected, actual)
+	}
+
+	count := 0
+	for range NewSet(1, 2, 3).Iter() {
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected Iter() to yield 3 items, got %d", count)
+	}
+
+	s.Clear()
+	if s.Len() != 0 {
+		t.Errorf("Expected set to be empty after Clear(), got %q", s.Slice())
+	}
+}
+
+// TestSetOperations tests the set algebra methods of Set
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+
+	a := NewSet(1, 2, 3)
+	b := NewSet(3, 4)
+
+	var tests = []struct {
+		name     string
+		actual   *Set[int]
+		expected []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4}},
+		{"Intersection", a.Intersection(b), []int{3}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 4}},
+		{"Union", new(Set[int]).Union(b), []int{3, 4}},
+	}
+
+	for _, test := range tests {
+		if actual := SortedSlice(test.actual); !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected %s to be %v, got %v", test.name, test.expected, actual)
+		}
+	}
+
+	if !NewSet(1, 3).IsSubset(a) || a.IsSubset(b) {
+		t.Errorf("Expected IsSubset to detect subsets")
+	}
+	if !a.Equal(NewSet(3, 2, 1)) || a.Equal(b) {
+		t.Errorf("Expected Equal to compare the items")
+	}
+	if a.Len() != 3 || b.Len() != 2 {
+		t.Errorf("Expected set operations to not modify the sets")
+	}
+}
+
+// TestSetJSON tests the JSON encoding of Set and SyncSet
+func TestSetJSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(NewSet("foo", "bar", "baz"))
+	if err != nil || string(b) != `["bar","baz","foo"]` {
+		t.Errorf(`Expected Set to be encoded as ["bar","baz","foo"], got %s (%v)`, b, err)
+	}
+
+	// Ordered items are sorted by value, not by their encoding
+	type id int
+	b, err = json.Marshal(NewSet[id](2, 10, 1, -3))
+	if err != nil || string(b) != "[-3,1,2,10]" {
+		t.Errorf("Expected Set to be encoded as [-3,1,2,10], got %s (%v)", b, err)
+	}
+	b, err = json.Marshal(NewSet(2.5, 10, 1))
+	if err != nil || string(b) != "[1,2.5,10]" {
+		t.Errorf("Expected Set to be encoded as [1,2.5,10], got %s (%v)", b, err)
+	}
+
+	// Sets stored by value are encoded too
+	w := struct {
+		S Set[string]
+	}{}
+	w.S.Add("b", "a")
+	b, err = json.Marshal(w)
+	if err != nil || string(b) != `{"S":["a","b"]}` {
+		t.Errorf(`Expected a Set field to be encoded as {"S":["a","b"]}, got %s (%v)`, b, err)
+	}
+
+	var s Set[int]
+	if err := json.Unmarshal([]byte("[3, 1, 3]"), &s); err != nil || !s.Equal(NewSet(1, 3)) {
+		t.Errorf("Expected [3, 1, 3] to be decoded as [1 3], got %v (%v)", SortedSlice(&s), err)
+	}
+	if err := json.Unmarshal([]byte(`{"foo": 1}`), &s); err == nil {
+		t.Errorf("Expected an object to not be decoded as a Set")
+	}
+
+	ss := NewSyncSet(2, 1)
+	b, err = json.Marshal(ss)
+	if err != nil || string(b) != "[1,2]" {
+		t.Errorf("Expected SyncSet to be encoded as [1,2], got %s (%v)", b, err)
+	}
+}
+
+// TestSyncSet tests the SyncSet concurrent usage
+func TestSyncSet(t *testing.T) {
+	t.Parallel()
+
+	var s SyncSet[int]
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Add(j)
+				s.Has(i)
+				s.Len()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if s.Len() != 100 {
+		t.Errorf("Expected SyncSet to contain 100 items, got %d", s.Len())
+	}
+
+	snapshot := s.Snapshot()
+	s.Remove(0)
+	if !snapshot.Has(0) || s.Has(0) {
+		t.Errorf("Expected Snapshot to be a copy of the set")
+	}
+}
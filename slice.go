@@ -2,44 +2,53 @@
 package gosc
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"iter"
+	"math"
 	"math/rand"
 	"reflect"
-	"sort"
+	"slices"
 	"strings"
-	"time"
 )
 
-// MapString a new slice containing the results of applying the function f to each string in the original slice.
-func MapString(s []string, f func(string) string) []string {
-	sm := make([]string, len(s))
+var (
+	// ErrInvalidWeights is returned when the weights of a random choice are not valid
+	ErrInvalidWeights = errors.New("gosc: weights must be non-negative, not all zero and match the items")
+	// ErrIndexOutOfRange is returned when an index is outside of the slice bounds
+	ErrIndexOutOfRange = errors.New("gosc: index out of range")
+	// ErrNilSlice is returned when a nil pointer to a slice is provided
+	ErrNilSlice = errors.New("gosc: nil slice pointer")
+)
+
+// Map returns a new slice containing the results of applying the function f to each item in the original slice.
+func Map[T, U any](s []T, f func(T) U) []U {
+	sm := make([]U, len(s))
 	for i, v := range s {
 		sm[i] = f(v)
 	}
 	return sm
 }
 
+// MapString a new slice containing the results of applying the function f to each string in the original slice.
+func MapString(s []string, f func(string) string) []string {
+	return Map(s, f)
+}
+
 // MapInt a new slice containing the results of applying the function f to each int in the original slice.
 func MapInt(s []int, f func(int) int) []int {
-	sm := make([]int, len(s))
-	for i, v := range s {
-		sm[i] = f(v)
-	}
-	return sm
+	return Map(s, f)
 }
 
 // MapFloat a new slice containing the results of applying the function f to each float64 in the original slice.
 func MapFloat(s []float64, f func(float64) float64) []float64 {
-	sm := make([]float64, len(s))
-	for i, v := range s {
-		sm[i] = f(v)
-	}
-	return sm
+	return Map(s, f)
 }
 
-// FilterString returns a new slice containing all strings in the slice that satisfy the predicate f.
-func FilterString(s []string, f func(string) bool) []string {
-	sf := make([]string, 0)
+// Filter returns a new slice containing all items in the slice that satisfy the predicate f.
+func Filter[T any](s []T, f func(T) bool) []T {
+	sf := make([]T, 0)
 	for _, v := range s {
 		if f(v) {
 			sf = append(sf, v)
@@ -49,43 +58,99 @@ func FilterString(s []string, f func(string) bool) []string {
 	return sf
 }
 
+// FilterString returns a new slice containing all strings in the slice that satisfy the predicate f.
+func FilterString(s []string, f func(string) bool) []string {
+	return Filter(s, f)
+}
+
 // FilterInt returns a new slice containing all ints in the slice that satisfy the predicate f.
 func FilterInt(s []int, f func(int) bool) []int {
-	sf := make([]int, 0)
-	for _, v := range s {
-		if f(v) {
-			sf = append(sf, v)
+	return Filter(s, f)
+}
+
+// FilterFloat returns a new slice containing all float64s in the slice that satisfy the predicate f.
+func FilterFloat(s []float64, f func(float64) bool) []float64 {
+	return Filter(s, f)
+}
+
+// Chunk splits the slice into groups of the given size. The last group may be smaller.
+// The groups share the backing array of the original slice; an empty result is returned if size is not positive.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		return [][]T{}
+	}
+
+	sc := make([][]T, 0, len(s)/size+1)
+	for len(s) > 0 {
+		n := min(size, len(s))
+		sc = append(sc, s[:n:n])
+		s = s[n:]
+	}
+
+	return sc
+}
+
+// Window returns the sliding windows of the given size over the slice, moving forward of step items every time.
+// Only full windows are returned; they share the backing array of the original slice.
+func Window[T any](s []T, size, step int) [][]T {
+	if size <= 0 || step <= 0 {
+		return [][]T{}
+	}
+
+	sw := make([][]T, 0)
+	for i := 0; size <= len(s)-i; i += step {
+		sw = append(sw, s[i:i+size:i+size])
+		if step > len(s)-i {
+			break
 		}
 	}
 
-	return sf
+	return sw
 }
 
-// FilterFloat returns a new slice containing all float64s in the slice that satisfy the predicate f.
-func FilterFloat(s []float64, f func(float64) bool) []float64 {
-	sf := make([]float64, 0)
+// Partition returns two new slices: the first one contains the items that satisfy the predicate f,
+// the second one contains the items that don't.
+func Partition[T any](s []T, f func(T) bool) ([]T, []T) {
+	matched := make([]T, 0)
+	unmatched := make([]T, 0)
 	for _, v := range s {
 		if f(v) {
-			sf = append(sf, v)
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
 		}
 	}
 
-	return sf
+	return matched, unmatched
 }
 
-// AllString returns true if all of the strings in the slice satisfy the predicate f
-func AllString(s []string, f func(string) bool) bool {
-	for _, v := range s {
-		if !f(v) {
-			return false
+// SplitAt splits the slice in two at the given index, which is clamped to the slice bounds.
+// Both parts share the backing array of the original slice.
+func SplitAt[T any](s []T, i int) ([]T, []T) {
+	i = max(0, min(i, len(s)))
+	return s[:i:i], s[i:]
+}
+
+// SplitWhen splits the slice between every two adjacent items for which the function f returns true.
+// No item is dropped and the groups share the backing array of the original slice.
+func SplitWhen[T any](s []T, f func(prev, cur T) bool) [][]T {
+	ss := make([][]T, 0)
+	start := 0
+	for i := 1; i < len(s); i++ {
+		if f(s[i-1], s[i]) {
+			ss = append(ss, s[start:i:i])
+			start = i
 		}
 	}
+	if start < len(s) {
+		ss = append(ss, s[start:])
+	}
 
-	return true
+	return ss
 }
 
-// AllInt returns true if all of the ints in the slice satisfy the predicate f
-func AllInt(s []int, f func(int) bool) bool {
+// All returns true if all of the items in the slice satisfy the predicate f
+func All[T any](s []T, f func(T) bool) bool {
 	for _, v := range s {
 		if !f(v) {
 			return false
@@ -95,52 +160,43 @@ func AllInt(s []int, f func(int) bool) bool {
 	return true
 }
 
+// Every is an alias of All
+func Every[T any](s []T, f func(T) bool) bool {
+	return All(s, f)
+}
+
+// AllString returns true if all of the strings in the slice satisfy the predicate f
+func AllString(s []string, f func(string) bool) bool {
+	return All(s, f)
+}
+
+// AllInt returns true if all of the ints in the slice satisfy the predicate f
+func AllInt(s []int, f func(int) bool) bool {
+	return All(s, f)
+}
+
 // AllFloat returns true if all of the float64s in the slice satisfy the predicate f
 func AllFloat(s []float64, f func(float64) bool) bool {
-	for _, v := range s {
-		if !f(v) {
-			return false
-		}
-	}
-
-	return true
+	return All(s, f)
 }
 
 // EveryString returns true if all of the strings in the slice satisfy the predicate f
 func EveryString(s []string, f func(string) bool) bool {
-	for _, v := range s {
-		if !f(v) {
-			return false
-		}
-	}
-
-	return true
+	return All(s, f)
 }
 
 // EveryInt returns true if all of the ints in the slice satisfy the predicate f
 func EveryInt(s []int, f func(int) bool) bool {
-	for _, v := range s {
-		if !f(v) {
-			return false
-		}
-	}
-
-	return true
+	return All(s, f)
 }
 
 // EveryFloat returns true if all of the float64s in the slice satisfy the predicate f
 func EveryFloat(s []float64, f func(float64) bool) bool {
-	for _, v := range s {
-		if !f(v) {
-			return false
-		}
-	}
-
-	return true
+	return All(s, f)
 }
 
-// AnyString returns true if one of the strings in the slice satisfies the predicate f
-func AnyString(s []string, f func(string) bool) bool {
+// Any returns true if one of the items in the slice satisfies the predicate f
+func Any[T any](s []T, f func(T) bool) bool {
 	for _, v := range s {
 		if f(v) {
 			return true
@@ -149,60 +205,194 @@ func AnyString(s []string, f func(string) bool) bool {
 	return false
 }
 
+// Some is an alias of Any
+func Some[T any](s []T, f func(T) bool) bool {
+	return Any(s, f)
+}
+
+// AnyString returns true if one of the strings in the slice satisfies the predicate f
+func AnyString(s []string, f func(string) bool) bool {
+	return Any(s, f)
+}
+
 // AnyInt returns true if one of the ints in the slice satisfies the predicate f
 func AnyInt(s []int, f func(int) bool) bool {
-	for _, v := range s {
-		if f(v) {
-			return true
-		}
-	}
-	return false
+	return Any(s, f)
 }
 
 // AnyFloat returns true if one of the float64s in the slice satisfies the predicate f
 func AnyFloat(s []float64, f func(float64) bool) bool {
-	for _, v := range s {
-		if f(v) {
-			return true
-		}
-	}
-	return false
+	return Any(s, f)
 }
 
 // SomeString returns true if one of the strings in the slice satisfies the predicate f
 func SomeString(s []string, f func(string) bool) bool {
-	for _, v := range s {
+	return Any(s, f)
+}
+
+// SomeInt returns true if one of the ints in the slice satisfies the predicate f
+func SomeInt(s []int, f func(int) bool) bool {
+	return Any(s, f)
+}
+
+// SomeFloat returns true if one of the float64s in the slice satisfies the predicate f
+func SomeFloat(s []float64, f func(float64) bool) bool {
+	return Any(s, f)
+}
+
+// Find returns the first item of the slice that satisfies the predicate f, and false if none does
+func Find[T any](s []T, f func(T) bool) (T, bool) {
+	if i := FindIndex(s, f); i != -1 {
+		return s[i], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first item of the slice that satisfies the predicate f or -1 if none does
+func FindIndex[T any](s []T, f func(T) bool) int {
+	for i, v := range s {
 		if f(v) {
-			return true
+			return i
 		}
 	}
-	return false
+
+	return -1
 }
 
-// SomeInt returns true if one of the ints in the slice satisfies the predicate f
-func SomeInt(s []int, f func(int) bool) bool {
+// FindLast returns the last item of the slice that satisfies the predicate f, and false if none does
+func FindLast[T any](s []T, f func(T) bool) (T, bool) {
+	if i := FindLastIndex(s, f); i != -1 {
+		return s[i], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// FindLastIndex returns the index of the last item of the slice that satisfies the predicate f or -1 if none does
+func FindLastIndex[T any](s []T, f func(T) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(s[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ContainsBy is an alias of Any
+func ContainsBy[T any](s []T, f func(T) bool) bool {
+	return Any(s, f)
+}
+
+// CountWhere returns how many items of the slice satisfy the predicate f
+func CountWhere[T any](s []T, f func(T) bool) int {
+	n := 0
 	for _, v := range s {
 		if f(v) {
-			return true
+			n++
 		}
 	}
-	return false
+
+	return n
 }
 
-// SomeFloat returns true if one of the float64s in the slice satisfies the predicate f
-func SomeFloat(s []float64, f func(float64) bool) bool {
+// Reduce reduces the slice to a single value, applying the function f to each item from left to right
+// starting from the initial accumulator value.
+func Reduce[T, U any](s []T, f func(U, T) U, init U) U {
+	acc := init
 	for _, v := range s {
-		if f(v) {
-			return true
+		acc = f(acc, v)
+	}
+
+	return acc
+}
+
+// ReduceIndex is like Reduce but the function f receives the index of the item too.
+func ReduceIndex[T, U any](s []T, f func(U, T, int) U, init U) U {
+	acc := init
+	for i, v := range s {
+		acc = f(acc, v, i)
+	}
+
+	return acc
+}
+
+// ReduceRight is like Reduce but iterates over the items from right to left.
+func ReduceRight[T, U any](s []T, f func(U, T) U, init U) U {
+	acc := init
+	for i := len(s) - 1; i >= 0; i-- {
+		acc = f(acc, s[i])
+	}
+
+	return acc
+}
+
+// Scan is like Reduce but returns a slice containing every intermediate accumulator value.
+func Scan[T, U any](s []T, f func(U, T) U, init U) []U {
+	ss := make([]U, len(s))
+	acc := init
+	for i, v := range s {
+		acc = f(acc, v)
+		ss[i] = acc
+	}
+
+	return ss
+}
+
+// Contains returns true if the value is in the slice
+func Contains[T comparable](s []T, v T) bool {
+	return IndexOf(s, v) != -1
+}
+
+// IndexOf returns the index of the first occurrence of the value in the slice or -1 if not found
+func IndexOf[T comparable](s []T, v T) int {
+	for i, sv := range s {
+		if sv == v {
+			return i
 		}
 	}
-	return false
+
+	return -1
 }
 
-// Index returns the index of an element in a slice or -1 if not found
+// LastIndexOf returns the index of the last occurrence of the value in the slice or -1 if not found
+func LastIndexOf[T comparable](s []T, v T) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// DeleteAt deletes the item at the given index from the slice, preserving the order of the others.
+// An error wrapping ErrIndexOutOfRange is returned if the index is not valid, ErrNilSlice if s is nil.
+func DeleteAt[T any](s *[]T, i int) error {
+	if s == nil {
+		return ErrNilSlice
+	}
+	if i < 0 || i >= len(*s) {
+		return fmt.Errorf("%w: %d with length %d", ErrIndexOutOfRange, i, len(*s))
+	}
+
+	*s = slices.Delete(*s, i, i+1)
+	return nil
+}
+
+// Index returns the index of an element in a slice or -1 if not found.
+// The slice must be passed as pointer, -1 is returned otherwise; see IndexOf for a type-safe version.
 func Index(s interface{}, t interface{}) int {
 	// Retrieve slices
-	sl := reflect.ValueOf(s).Elem()
+	valueOf := reflect.ValueOf(s)
+	if valueOf.Kind() != reflect.Ptr || valueOf.Elem().Kind() != reflect.Slice {
+		return -1
+	}
+
+	sl := valueOf.Elem()
 	if sl.Len() == 0 {
 		return -1
 	}
@@ -231,7 +421,68 @@ func Indexi(s []string, t string) int {
 	return -1
 }
 
-// Delete an item from a slice
+// SliceUnique returns a new slice without duplicated items, preserving the order of first appearance.
+func SliceUnique[T comparable](s []T) []T {
+	return SliceUniqueBy(s, identity[T])
+}
+
+// identity returns its argument, used as the key function of the comparable helpers
+func identity[T any](v T) T {
+	return v
+}
+
+// SliceUniqueBy returns a new slice without the items whose key, computed by the function f, has already been seen.
+// The order of first appearance is preserved.
+func SliceUniqueBy[T any, K comparable](s []T, f func(T) K) []T {
+	seen := make(map[K]struct{}, len(s))
+	su := make([]T, 0)
+	for _, v := range s {
+		k := f(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		su = append(su, v)
+	}
+
+	return su
+}
+
+// SliceUniqueSorted returns a new slice without duplicated items from an already sorted slice.
+// It doesn't allocate a map, comparing every item with the previous one only.
+func SliceUniqueSorted[T comparable](s []T) []T {
+	su := make([]T, 0)
+	for i, v := range s {
+		if i > 0 && v == s[i-1] {
+			continue
+		}
+		su = append(su, v)
+	}
+
+	return su
+}
+
+// Duplicates returns a new slice containing the items that occur more than once in the slice,
+// in the order of their first appearance.
+func Duplicates[T comparable](s []T) []T {
+	count := make(map[T]int, len(s))
+	for _, v := range s {
+		count[v]++
+	}
+
+	sd := make([]T, 0)
+	for _, v := range s {
+		if count[v] > 1 {
+			sd = append(sd, v)
+			count[v] = 0
+		}
+	}
+
+	return sd
+}
+
+// Delete an item from a slice. Only slices of strings, ints and float64s are supported;
+// see DeleteAt for a type-safe version reporting errors.
 func Delete(s interface{}, i int) {
 	// Retrieve slice
 	sl := reflect.ValueOf(s).Elem()
@@ -245,72 +496,117 @@ func Delete(s interface{}, i int) {
 	switch s.(type) {
 	case *[]string:
 		sli := sl.Interface().([]string)
-		*s.(*[]string) = append(sli[:i], sli[i+1:]...)
+		*s.(*[]string) = slices.Delete(sli, i, i+1)
 	case *[]int:
 		sli := sl.Interface().([]int)
-		*s.(*[]int) = append(sli[:i], sli[i+1:]...)
+		*s.(*[]int) = slices.Delete(sli, i, i+1)
 	case *[]float64:
 		sli := sl.Interface().([]float64)
-		*s.(*[]float64) = append(sli[:i], sli[i+1:]...)
+		*s.(*[]float64) = slices.Delete(sli, i, i+1)
 	default:
 		return
 	}
 }
 
-// Rsort reverses the order (desc) of a slice
-func Rsort(s interface{}) {
-	// Retrieve slice
-	sl := reflect.ValueOf(s).Elem()
+// DeleteRange deletes the items in the range [i, j) from the slice, preserving the order of the others.
+// An error wrapping ErrIndexOutOfRange is returned if the range is not valid, ErrNilSlice if s is nil.
+func DeleteRange[T any](s *[]T, i, j int) error {
+	if s == nil {
+		return ErrNilSlice
+	}
+	if i < 0 || j > len(*s) || i > j {
+		return fmt.Errorf("%w: [%d:%d] with length %d", ErrIndexOutOfRange, i, j, len(*s))
+	}
 
-	// Handle types or exit if not supported type
-	switch s.(type) {
-	case *[]string:
-		sli := sl.Interface().([]string)
-		sort.Sort(sort.Reverse(sort.StringSlice(sli)))
-		*s.(*[]string) = sli
-	case *[]int:
-		sli := sl.Interface().([]int)
-		sort.Sort(sort.Reverse(sort.IntSlice(sli)))
-		*s.(*[]int) = sli
-	case *[]float64:
-		sli := sl.Interface().([]float64)
-		sort.Sort(sort.Reverse(sort.Float64Slice(sli)))
-		*s.(*[]float64) = sli
-	default:
-		return
+	*s = slices.Delete(*s, i, j)
+	return nil
+}
+
+// DeleteUnordered deletes the item at the given index replacing it with the last one, in O(1).
+// The order of the items is not preserved.
+func DeleteUnordered[T any](s *[]T, i int) error {
+	if s == nil {
+		return ErrNilSlice
 	}
+	if i < 0 || i >= len(*s) {
+		return fmt.Errorf("%w: %d with length %d", ErrIndexOutOfRange, i, len(*s))
+	}
+
+	last := len(*s) - 1
+	(*s)[i] = (*s)[last]
+	clear((*s)[last:])
+	*s = (*s)[:last]
+	return nil
 }
 
-// ReverseSort is an alias of Rsort
-func ReverseSort(s interface{}) {
-	// Retrieve slice
-	sl := reflect.ValueOf(s).Elem()
+// RemoveIf removes in place all the items of the slice that satisfy the predicate f,
+// returning how many items were removed
+func RemoveIf[T any](s *[]T, f func(T) bool) int {
+	if s == nil {
+		return 0
+	}
 
-	// Handle types or exit if not supported type
-	switch s.(type) {
-	case *[]string:
-		sli := sl.Interface().([]string)
-		sort.Sort(sort.Reverse(sort.StringSlice(sli)))
-		*s.(*[]string) = sli
-	case *[]int:
-		sli := sl.Interface().([]int)
-		sort.Sort(sort.Reverse(sort.IntSlice(sli)))
-		*s.(*[]int) = sli
-	case *[]float64:
-		sli := sl.Interface().([]float64)
-		sort.Sort(sort.Reverse(sort.Float64Slice(sli)))
-		*s.(*[]float64) = sli
-	default:
+	n := len(*s)
+	*s = slices.DeleteFunc(*s, f)
+	return n - len(*s)
+}
+
+// InsertAt inserts the values at the given index of the slice, shifting the following items.
+// The index can be equal to the length of the slice to append the values.
+func InsertAt[T any](s *[]T, i int, v ...T) error {
+	if s == nil {
+		return ErrNilSlice
+	}
+	if i < 0 || i > len(*s) {
+		return fmt.Errorf("%w: %d with length %d", ErrIndexOutOfRange, i, len(*s))
+	}
+
+	*s = slices.Insert(*s, i, v...)
+	return nil
+}
+
+// Insert is an alias of InsertAt
+func Insert[T any](s *[]T, i int, v ...T) error {
+	return InsertAt(s, i, v...)
+}
+
+// Move moves in place the item at index from to index to, shifting the items in between
+func Move[T any](s []T, from, to int) error {
+	if from < 0 || from >= len(s) || to < 0 || to >= len(s) {
+		return fmt.Errorf("%w: %d to %d with length %d", ErrIndexOutOfRange, from, to, len(s))
+	}
+
+	v := s[from]
+	if from < to {
+		copy(s[from:to], s[from+1:to+1])
+	} else {
+		copy(s[to+1:from+1], s[to:from])
+	}
+	s[to] = v
+	return nil
+}
+
+// Compact replaces in place the consecutive runs of equal items with a single copy, like the Unix uniq command
+func Compact[T comparable](s *[]T) {
+	if s == nil {
 		return
 	}
+
+	*s = slices.Compact(*s)
+}
+
+// Rsort reverses the order (desc) of a slice of any ordered type
+func Rsort[T cmp.Ordered](s *[]T) {
+	SortWith(*s, Asc[T]().Desc())
+}
+
+// ReverseSort is an alias of Rsort
+func ReverseSort[T cmp.Ordered](s *[]T) {
+	Rsort(s)
 }
 
 // EqSlices returns true if two slices are equal (not in-depth, for that use reflect.DeepEqual)
 func EqSlices(a, b interface{}) bool {
-	// Retrieve slices
-	sl1 := reflect.ValueOf(a).Elem()
-	sl2 := reflect.ValueOf(b).Elem()
-
 	if a == nil && b == nil {
 		return true
 	}
@@ -319,11 +615,15 @@ func EqSlices(a, b interface{}) bool {
 		return false
 	}
 
-	if sl1.Len() != sl2.Len() {
+	// Retrieve slices
+	sl1 := reflect.ValueOf(a).Elem()
+	sl2 := reflect.ValueOf(b).Elem()
+
+	if sl1.Type() != sl2.Type() {
 		return false
 	}
 
-	if fmt.Sprintf("%T", sl1.Interface()) != fmt.Sprintf("%T", sl1.Interface()) {
+	if sl1.Len() != sl2.Len() {
 		return false
 	}
 
@@ -338,9 +638,6 @@ func EqSlices(a, b interface{}) bool {
 
 // SliceRand assign a random value from a string to the "r" arg
 func SliceRand(s interface{}, r interface{}) {
-	// Initialize global pseudo random generator
-	rand.Seed(time.Now().Unix())
-
 	// Retrieve slice
 	sl := reflect.ValueOf(s).Elem()
 
@@ -348,13 +645,13 @@ func SliceRand(s interface{}, r interface{}) {
 	switch s.(type) {
 	case *[]string:
 		sli := sl.Interface().([]string)
-		*r.(*string) = sli[rand.Intn(len(sli))]
+		*r.(*string) = sli[defaultRandom.Intn(len(sli))]
 	case *[]int:
 		sli := sl.Interface().([]int)
-		*r.(*int) = sli[rand.Intn(len(sli))]
+		*r.(*int) = sli[defaultRandom.Intn(len(sli))]
 	case *[]float64:
 		sli := sl.Interface().([]float64)
-		*r.(*float64) = sli[rand.Intn(len(sli))]
+		*r.(*float64) = sli[defaultRandom.Intn(len(sli))]
 	default:
 		return
 	}
@@ -362,8 +659,7 @@ func SliceRand(s interface{}, r interface{}) {
 
 // SliceRandString returns a random value from a string slice
 func SliceRandString(s []string) string {
-	rand.Seed(time.Now().Unix()) // initialize global pseudo random generator
-	return s[rand.Intn(len(s))]
+	return RandomPick(defaultRandom, s)
 }
 
 // SliceRandS is an alias of SliceRandString
@@ -373,8 +669,7 @@ func SliceRandS(s []string) string {
 
 // SliceRandInt returns a random value from an int slice
 func SliceRandInt(s []int) int {
-	rand.Seed(time.Now().Unix()) // initialize global pseudo random generator
-	return s[rand.Intn(len(s))]
+	return RandomPick(defaultRandom, s)
 }
 
 // SliceRandI is an alias of SliceRandInt
@@ -384,8 +679,7 @@ func SliceRandI(s []int) int {
 
 // SliceRandFloat returns a random value from a float64 slice
 func SliceRandFloat(s []float64) float64 {
-	rand.Seed(time.Now().Unix()) // initialize global pseudo random generator
-	return s[rand.Intn(len(s))]
+	return RandomPick(defaultRandom, s)
 }
 
 // SliceRandF is an alias of SliceRandFloat
@@ -393,7 +687,126 @@ func SliceRandF(s []float64) float64 {
 	return SliceRandFloat(s)
 }
 
-// InSlice returns a boolean if the value is in the slice
+// Shuffle randomizes the order of the items of the slice in place using the Fisher-Yates algorithm.
+// An optional *rand.Rand can be provided to get a deterministic result.
+func Shuffle[T any](s []T, r ...*rand.Rand) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := randIntn(r, i+1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Shuffled returns a shuffled copy of the slice, leaving the original one untouched.
+func Shuffled[T any](s []T, r ...*rand.Rand) []T {
+	ss := make([]T, len(s))
+	copy(ss, s)
+	Shuffle(ss, r...)
+	return ss
+}
+
+// Sample returns k random items from the slice, without replacement.
+// If k is greater than the length of the slice, all the items are returned in random order.
+func Sample[T any](s []T, k int, r ...*rand.Rand) []T {
+	if k > len(s) {
+		k = len(s)
+	}
+	if k < 0 {
+		k = 0
+	}
+
+	ss := make([]T, len(s))
+	copy(ss, s)
+
+	// Partial Fisher-Yates: only the first k positions need to be drawn
+	for i := 0; i < k; i++ {
+		j := i + randIntn(r, len(ss)-i)
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+
+	return ss[:k:k]
+}
+
+// WeightedChoice returns a random item from the slice, where the probability of every item
+// is proportional to its weight.
+// An error is returned if the weights don't match the items or if they are negative or all zero.
+func WeightedChoice[T any](s []T, weights []float64, r ...*rand.Rand) (T, error) {
+	var zero T
+	if len(s) == 0 || len(s) != len(weights) {
+		return zero, ErrInvalidWeights
+	}
+
+	var total float64
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return zero, ErrInvalidWeights
+		}
+		total += w
+	}
+	if total == 0 {
+		return zero, ErrInvalidWeights
+	}
+
+	target := randFloat64(r) * total
+	for i, w := range weights {
+		if target < w {
+			return s[i], nil
+		}
+		target -= w
+	}
+
+	// Floating point rounding can leave a tiny remainder, fall back to the last weighted item
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return s[i], nil
+		}
+	}
+
+	return zero, ErrInvalidWeights
+}
+
+// ReservoirSample returns k random items from a stream of unknown length, reading it only once.
+func ReservoirSample[T any](seq iter.Seq[T], k int, r ...*rand.Rand) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	// k can be much larger than the stream, let append grow the reservoir
+	rs := make([]T, 0)
+	n := 0
+	for v := range seq {
+		n++
+		if len(rs) < k {
+			rs = append(rs, v)
+			continue
+		}
+		if j := randIntn(r, n); j < k {
+			rs[j] = v
+		}
+	}
+
+	return rs
+}
+
+// randIntn returns a random int in [0, n) from the optional generator or from the default one
+func randIntn(r []*rand.Rand, n int) int {
+	if len(r) > 0 && r[0] != nil {
+		return r[0].Intn(n)
+	}
+
+	return defaultRandom.Intn(n)
+}
+
+// randFloat64 returns a random float64 in [0.0, 1.0) from the optional generator or from the default one
+func randFloat64(r []*rand.Rand) float64 {
+	if len(r) > 0 && r[0] != nil {
+		return r[0].Float64()
+	}
+
+	return defaultRandom.Float64()
+}
+
+// InSlice returns a boolean if the value is in the slice. It panics if the slice is not passed as pointer;
+// see Contains for a type-safe version.
 // v = value to find
 // s = slice
 func InSlice(v interface{}, s interface{}) bool {
@@ -1,6 +1,13 @@
 package gosc
 
 import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -99,6 +106,139 @@ func TestFilterInt(t *testing.T) {
 	}
 }
 
+// TestChunk tests the Chunk function
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		size     int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{[]int{1, 2}, 5, [][]int{{1, 2}}},
+		{[]int{1, 2}, 0, [][]int{}},
+		{[]int{}, 3, [][]int{}},
+		{[]int{1, 2, 3}, math.MaxInt, [][]int{{1, 2, 3}}},
+	}
+
+	for _, test := range tests {
+		actual := Chunk(test.s, test.size)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected Chunk(%v, %d) to be %v, got %v", test.s, test.size, test.expected, actual)
+		}
+	}
+
+	// Appending to a chunk must not overwrite the next one
+	s := []int{1, 2, 3, 4}
+	c := Chunk(s, 2)
+	_ = append(c[0], 9)
+	if s[2] != 3 {
+		t.Errorf("Expected appending to a chunk to not modify the original slice, got %v", s)
+	}
+}
+
+// TestWindow tests the Window function
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{[]int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{[]int{1, 2, 3, 4, 5}, 2, 3, [][]int{{1, 2}, {4, 5}}},
+		{[]int{1, 2}, 3, 1, [][]int{}},
+		{[]int{1, 2}, 1, 0, [][]int{}},
+		{[]int{1, 2, 3}, 1, math.MaxInt, [][]int{{1}}},
+		{[]int{1, 2, 3}, math.MaxInt, 1, [][]int{}},
+	}
+
+	for _, test := range tests {
+		actual := Window(test.s, test.size, test.step)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected Window(%v, %d, %d) to be %v, got %v", test.s, test.size, test.step, test.expected, actual)
+		}
+	}
+}
+
+// TestPartition tests the Partition function
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s                 []string
+		expectedMatched   []string
+		expectedUnmatched []string
+	}{
+		{[]string{"foo", "bar", "baz"}, []string{"bar", "baz"}, []string{"foo"}},
+		{[]string{"foo"}, []string{}, []string{"foo"}},
+		{[]string{}, []string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		matched, unmatched := Partition(test.s, func(s string) bool {
+			return strings.HasPrefix(s, "b")
+		})
+		if !EqSlices(&matched, &test.expectedMatched) || !EqSlices(&unmatched, &test.expectedUnmatched) {
+			t.Errorf("Expected Partition(%q, fn) to be %q %q, got %q %q", test.s, test.expectedMatched, test.expectedUnmatched, matched, unmatched)
+		}
+	}
+}
+
+// TestSplitAt tests the SplitAt function
+func TestSplitAt(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s             []int
+		i             int
+		expectedLeft  []int
+		expectedRight []int
+	}{
+		{[]int{1, 2, 3}, 1, []int{1}, []int{2, 3}},
+		{[]int{1, 2, 3}, 0, []int{}, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 3, []int{1, 2, 3}, []int{}},
+		{[]int{1, 2, 3}, 10, []int{1, 2, 3}, []int{}},
+		{[]int{1, 2, 3}, -1, []int{}, []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		left, right := SplitAt(test.s, test.i)
+		if !EqSlices(&left, &test.expectedLeft) || !EqSlices(&right, &test.expectedRight) {
+			t.Errorf("Expected SplitAt(%v, %d) to be %v %v, got %v %v", test.s, test.i, test.expectedLeft, test.expectedRight, left, right)
+		}
+	}
+}
+
+// TestSplitWhen tests the SplitWhen function
+func TestSplitWhen(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 7, 8, 10}, [][]int{{1, 2, 3}, {7, 8}, {10}}},
+		{[]int{1, 2, 3}, [][]int{{1, 2, 3}}},
+		{[]int{5}, [][]int{{5}}},
+		{[]int{}, [][]int{}},
+	}
+
+	for _, test := range tests {
+		actual := SplitWhen(test.s, func(prev, cur int) bool {
+			return cur-prev > 1
+		})
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected SplitWhen(%v, fn) to be %v, got %v", test.s, test.expected, actual)
+		}
+	}
+}
+
 // TestAllString tests the AllString function
 func TestAllString(t *testing.T) {
 	t.Parallel()
@@ -198,6 +338,229 @@ func TestAnyInt(t *testing.T) {
 	}
 }
 
+// TestMap tests the Map function with a different output type
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		expected []string
+	}{
+		{[]int{0, 2, 4}, []string{"0", "2", "4"}},
+		{[]int{-5}, []string{"-5"}},
+		{[]int{}, []string{}},
+	}
+
+	for _, test := range tests {
+		actual := Map(test.s, strconv.Itoa)
+		if !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected Map(%v, fn) to be %q, got %q", test.s, test.expected, actual)
+		}
+	}
+}
+
+// TestFilter tests the Filter function with a custom type
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		name  string
+		admin bool
+	}
+
+	var tests = []struct {
+		s        []user
+		expected []user
+	}{
+		{[]user{{"foo", true}, {"bar", false}, {"baz", true}}, []user{{"foo", true}, {"baz", true}}},
+		{[]user{{"foo", false}}, []user{}},
+		{[]user{}, []user{}},
+	}
+
+	for _, test := range tests {
+		actual := Filter(test.s, func(u user) bool {
+			return u.admin
+		})
+		if !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected Filter(%v, fn) to be %v, got %v", test.s, test.expected, actual)
+		}
+	}
+}
+
+// TestAllAny tests the All and Any functions with int64 slices
+func TestAllAny(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s           []int64
+		expectedAll bool
+		expectedAny bool
+	}{
+		{[]int64{2, 4, 6}, true, true},
+		{[]int64{1, 4, 6}, false, true},
+		{[]int64{1, 3}, false, false},
+		{[]int64{}, true, false},
+	}
+
+	even := func(i int64) bool {
+		return i%2 == 0
+	}
+
+	for _, test := range tests {
+		if actual := All(test.s, even); actual != test.expectedAll {
+			t.Errorf("Expected All(%v, fn) to be %v, got %v", test.s, test.expectedAll, actual)
+		}
+		if actual := Every(test.s, even); actual != test.expectedAll {
+			t.Errorf("Expected Every(%v, fn) to be %v, got %v", test.s, test.expectedAll, actual)
+		}
+		if actual := Any(test.s, even); actual != test.expectedAny {
+			t.Errorf("Expected Any(%v, fn) to be %v, got %v", test.s, test.expectedAny, actual)
+		}
+		if actual := Some(test.s, even); actual != test.expectedAny {
+			t.Errorf("Expected Some(%v, fn) to be %v, got %v", test.s, test.expectedAny, actual)
+		}
+	}
+}
+
+// TestFind tests the Find, FindIndex, FindLast and FindLastIndex functions
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		id    int
+		email string
+	}
+
+	users := []user{{1, "foo@example.com"}, {2, "bar@example.com"}, {3, "foo@example.com"}}
+
+	var tests = []struct {
+		email             string
+		expectedIndex     int
+		expectedLastIndex int
+	}{
+		{"foo@example.com", 0, 2},
+		{"bar@example.com", 1, 1},
+		{"baz@example.com", -1, -1},
+	}
+
+	for _, test := range tests {
+		byEmail := func(u user) bool {
+			return u.email == test.email
+		}
+
+		if actual := FindIndex(users, byEmail); actual != test.expectedIndex {
+			t.Errorf("Expected FindIndex(%q) to be %d, got %d", test.email, test.expectedIndex, actual)
+		}
+		if actual := FindLastIndex(users, byEmail); actual != test.expectedLastIndex {
+			t.Errorf("Expected FindLastIndex(%q) to be %d, got %d", test.email, test.expectedLastIndex, actual)
+		}
+
+		u, ok := Find(users, byEmail)
+		if ok != (test.expectedIndex != -1) || (ok && u != users[test.expectedIndex]) || (!ok && u != user{}) {
+			t.Errorf("Expected Find(%q) to match index %d, got %v %v", test.email, test.expectedIndex, u, ok)
+		}
+
+		u, ok = FindLast(users, byEmail)
+		if ok != (test.expectedLastIndex != -1) || (ok && u != users[test.expectedLastIndex]) || (!ok && u != user{}) {
+			t.Errorf("Expected FindLast(%q) to match index %d, got %v %v", test.email, test.expectedLastIndex, u, ok)
+		}
+	}
+}
+
+// TestCountWhere tests the CountWhere and ContainsBy functions
+func TestCountWhere(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []string
+		expected int
+	}{
+		{[]string{"foo", "bar", "baz"}, 2},
+		{[]string{"foo"}, 0},
+		{[]string{}, 0},
+	}
+
+	for _, test := range tests {
+		prefix := func(s string) bool {
+			return strings.HasPrefix(s, "ba")
+		}
+
+		if actual := CountWhere(test.s, prefix); actual != test.expected {
+			t.Errorf("Expected CountWhere(%q, fn) to be %d, got %d", test.s, test.expected, actual)
+		}
+		if actual := ContainsBy(test.s, prefix); actual != (test.expected > 0) {
+			t.Errorf("Expected ContainsBy(%q, fn) to be %v, got %v", test.s, test.expected > 0, actual)
+		}
+	}
+}
+
+// TestReduce tests the Reduce, ReduceIndex and ReduceRight functions
+func TestReduce(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s             []string
+		expected      string
+		expectedRight string
+		expectedIndex string
+	}{
+		{[]string{"foo", "bar", "baz"}, "foobarbaz", "bazbarfoo", "0foo1bar2baz"},
+		{[]string{"foo"}, "foo", "foo", "0foo"},
+		{[]string{}, "", "", ""},
+	}
+
+	concat := func(acc string, s string) string {
+		return acc + s
+	}
+
+	for _, test := range tests {
+		if actual := Reduce(test.s, concat, ""); actual != test.expected {
+			t.Errorf("Expected Reduce(%q, fn, \"\") to be %q, got %q", test.s, test.expected, actual)
+		}
+		if actual := ReduceRight(test.s, concat, ""); actual != test.expectedRight {
+			t.Errorf("Expected ReduceRight(%q, fn, \"\") to be %q, got %q", test.s, test.expectedRight, actual)
+		}
+		actual := ReduceIndex(test.s, func(acc string, s string, i int) string {
+			return acc + strconv.Itoa(i) + s
+		}, "")
+		if actual != test.expectedIndex {
+			t.Errorf("Expected ReduceIndex(%q, fn, \"\") to be %q, got %q", test.s, test.expectedIndex, actual)
+		}
+	}
+
+	sum := Reduce([]int{1, 2, 3, 4}, func(acc float64, i int) float64 {
+		return acc + float64(i)
+	}, 0.5)
+	if sum != 10.5 {
+		t.Errorf("Expected Reduce([1 2 3 4], fn, 0.5) to be 10.5, got %v", sum)
+	}
+}
+
+// TestScan tests the Scan function
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		init     int
+		expected []int
+	}{
+		{[]int{1, 2, 3, 4}, 0, []int{1, 3, 6, 10}},
+		{[]int{1, 2, 3, 4}, 10, []int{11, 13, 16, 20}},
+		{[]int{-1}, 0, []int{-1}},
+		{[]int{}, 5, []int{}},
+	}
+
+	for _, test := range tests {
+		actual := Scan(test.s, func(acc int, i int) int {
+			return acc + i
+		}, test.init)
+		if !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected Scan(%v, fn, %d) to be %v, got %v", test.s, test.init, test.expected, actual)
+		}
+	}
+}
+
 // TestIndexi tests the Indexi function
 func TestIndexi(t *testing.T) {
 	t.Parallel()
@@ -223,6 +586,76 @@ func TestIndexi(t *testing.T) {
 	}
 }
 
+// TestSliceUnique tests the SliceUnique and SliceUniqueSorted functions
+func TestSliceUnique(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []string
+		expected []string
+	}{
+		{[]string{"foo", "bar", "foo", "baz", "bar"}, []string{"foo", "bar", "baz"}},
+		{[]string{"foo", "\u0066\u006f\u006f"}, []string{"foo"}},
+		{[]string{"foo", "bar"}, []string{"foo", "bar"}},
+		{[]string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		actual := SliceUnique(test.s)
+		if !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected SliceUnique(%q) to be %q, got %q", test.s, test.expected, actual)
+		}
+	}
+
+	sorted := []int{-1, -1, 0, 3, 3, 3, 7}
+	expected := []int{-1, 0, 3, 7}
+	if actual := SliceUniqueSorted(sorted); !EqSlices(&actual, &expected) {
+		t.Errorf("Expected SliceUniqueSorted(%v) to be %v, got %v", sorted, expected, actual)
+	}
+}
+
+// TestSliceUniqueBy tests the SliceUniqueBy function
+func TestSliceUniqueBy(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		id   int
+		name string
+	}
+
+	s := []user{{1, "foo"}, {2, "bar"}, {1, "baz"}, {3, "foo"}}
+	expected := []user{{1, "foo"}, {2, "bar"}, {3, "foo"}}
+
+	actual := SliceUniqueBy(s, func(u user) int {
+		return u.id
+	})
+	if !EqSlices(&actual, &expected) {
+		t.Errorf("Expected SliceUniqueBy(%v, fn) to be %v, got %v", s, expected, actual)
+	}
+}
+
+// TestDuplicates tests the Duplicates function
+func TestDuplicates(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		expected []int
+	}{
+		{[]int{1, 2, 3, 2, 1, 2}, []int{1, 2}},
+		{[]int{5, 3, 3, 5}, []int{5, 3}},
+		{[]int{1, 2, 3}, []int{}},
+		{[]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		actual := Duplicates(test.s)
+		if !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected Duplicates(%v) to be %v, got %v", test.s, test.expected, actual)
+		}
+	}
+}
+
 // TestStringIndex tests the Index function with strings slice
 func TestStringIndex(t *testing.T) {
 	t.Parallel()
@@ -390,3 +823,366 @@ func TestInIntSlice(t *testing.T) {
 		}
 	}
 }
+
+// TestShuffle tests the Shuffle and Shuffled functions
+func TestShuffle(t *testing.T) {
+	t.Parallel()
+
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	a := Shuffled(s, rand.New(rand.NewSource(42)))
+	b := Shuffled(s, rand.New(rand.NewSource(42)))
+
+	if !EqSlices(&a, &b) {
+		t.Errorf("Expected Shuffled(%v) with the same seed to be deterministic, got %v and %v", s, a, b)
+	}
+	if expected := []int{1, 2, 3, 4, 5, 6, 7, 8}; !EqSlices(&s, &expected) {
+		t.Errorf("Expected Shuffled(%v) to not modify the original slice", s)
+	}
+
+	Shuffle(s, rand.New(rand.NewSource(42)))
+	if !EqSlices(&s, &a) {
+		t.Errorf("Expected Shuffle(%v) to be %v, got %v", s, a, s)
+	}
+
+	sort.Ints(a)
+	if expected := []int{1, 2, 3, 4, 5, 6, 7, 8}; !EqSlices(&a, &expected) {
+		t.Errorf("Expected Shuffled slice to contain the same items, got %v", a)
+	}
+}
+
+// TestSample tests the Sample function
+func TestSample(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []string
+		k        int
+		expected int
+	}{
+		{[]string{"foo", "bar", "baz", "dog"}, 2, 2},
+		{[]string{"foo", "bar", "baz", "dog"}, 4, 4},
+		{[]string{"foo", "bar"}, 5, 2},
+		{[]string{"foo", "bar"}, -1, 0},
+		{[]string{}, 1, 0},
+	}
+
+	for _, test := range tests {
+		actual := Sample(test.s, test.k, rand.New(rand.NewSource(1)))
+		if len(actual) != test.expected {
+			t.Errorf("Expected Sample(%q, %d) to have length %d, got %q", test.s, test.k, test.expected, actual)
+		}
+		if len(SliceUnique(actual)) != len(actual) {
+			t.Errorf("Expected Sample(%q, %d) to not repeat items, got %q", test.s, test.k, actual)
+		}
+		for _, v := range actual {
+			if Index(&test.s, v) == -1 {
+				t.Errorf("Expected Sample(%q, %d) to pick items from the slice, got %q", test.s, test.k, actual)
+			}
+		}
+	}
+}
+
+// TestWeightedChoice tests the WeightedChoice function
+func TestWeightedChoice(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []string
+		weights  []float64
+		expected string
+		err      error
+	}{
+		{[]string{"foo", "bar", "baz"}, []float64{0, 1, 0}, "bar", nil},
+		{[]string{"foo", "bar", "baz"}, []float64{0, 0, 2.5}, "baz", nil},
+		{[]string{"foo", "bar"}, []float64{1}, "", ErrInvalidWeights},
+		{[]string{"foo", "bar"}, []float64{0, 0}, "", ErrInvalidWeights},
+		{[]string{"foo", "bar"}, []float64{-1, 2}, "", ErrInvalidWeights},
+		{[]string{}, []float64{}, "", ErrInvalidWeights},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, test := range tests {
+		actual, err := WeightedChoice(test.s, test.weights, r)
+		if actual != test.expected || err != test.err {
+			t.Errorf("Expected WeightedChoice(%q, %v) to be %q (%v), got %q (%v)", test.s, test.weights, test.expected, test.err, actual, err)
+		}
+	}
+}
+
+// TestReservoirSample tests the ReservoirSample function
+func TestReservoirSample(t *testing.T) {
+	t.Parallel()
+
+	stream := func(yield func(int) bool) {
+		for i := 0; i < 1000; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	a := ReservoirSample(stream, 10, rand.New(rand.NewSource(7)))
+	b := ReservoirSample(stream, 10, rand.New(rand.NewSource(7)))
+	if len(a) != 10 || !EqSlices(&a, &b) {
+		t.Errorf("Expected ReservoirSample with the same seed to return 10 equal items, got %v and %v", a, b)
+	}
+	if len(SliceUnique(a)) != 10 {
+		t.Errorf("Expected ReservoirSample to not repeat items, got %v", a)
+	}
+
+	if actual := ReservoirSample(stream, 0); len(actual) != 0 {
+		t.Errorf("Expected ReservoirSample(stream, 0) to be empty, got %v", actual)
+	}
+	if actual := ReservoirSample(stream, math.MaxInt); len(actual) != 1000 {
+		t.Errorf("Expected ReservoirSample(stream, math.MaxInt) to return the 1000 items, got %d", len(actual))
+	}
+}
+
+// TestIndexOf tests the IndexOf, LastIndexOf and Contains functions
+func TestIndexOf(t *testing.T) {
+	t.Parallel()
+
+	type point struct {
+		x, y int
+	}
+
+	var tests = []struct {
+		haystack      []point
+		needle        point
+		expected      int
+		expectedLast  int
+		expectedFound bool
+	}{
+		{[]point{{1, 2}, {3, 4}, {1, 2}}, point{1, 2}, 0, 2, true},
+		{[]point{{1, 2}, {3, 4}}, point{3, 4}, 1, 1, true},
+		{[]point{{1, 2}}, point{2, 1}, -1, -1, false},
+		{[]point{}, point{}, -1, -1, false},
+	}
+
+	for _, test := range tests {
+		if actual := IndexOf(test.haystack, test.needle); actual != test.expected {
+			t.Errorf("Expected IndexOf(%v, %v) to be %d, got %d", test.haystack, test.needle, test.expected, actual)
+		}
+		if actual := LastIndexOf(test.haystack, test.needle); actual != test.expectedLast {
+			t.Errorf("Expected LastIndexOf(%v, %v) to be %d, got %d", test.haystack, test.needle, test.expectedLast, actual)
+		}
+		if actual := Contains(test.haystack, test.needle); actual != test.expectedFound {
+			t.Errorf("Expected Contains(%v, %v) to be %v, got %v", test.haystack, test.needle, test.expectedFound, actual)
+		}
+	}
+
+	bools := []bool{false, true}
+	if actual := IndexOf(bools, true); actual != 1 {
+		t.Errorf("Expected IndexOf(%v, true) to be 1, got %d", bools, actual)
+	}
+
+	if actual := Index(bools, true); actual != -1 {
+		t.Errorf("Expected Index with a non-pointer slice to be -1, got %d", actual)
+	}
+}
+
+// TestDeleteAt tests the DeleteAt function
+func TestDeleteAt(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []bool
+		i        int
+		expected []bool
+		err      error
+	}{
+		{[]bool{true, false, true}, 1, []bool{true, true}, nil},
+		{[]bool{true, false}, 0, []bool{false}, nil},
+		{[]bool{true}, 0, []bool{}, nil},
+		{[]bool{true, false}, 2, []bool{true, false}, ErrIndexOutOfRange},
+		{[]bool{true, false}, -1, []bool{true, false}, ErrIndexOutOfRange},
+		{[]bool{}, 0, []bool{}, ErrIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		s := make([]bool, len(test.s))
+		copy(s, test.s)
+
+		err := DeleteAt(&s, test.i)
+		if !EqSlices(&s, &test.expected) || !errors.Is(err, test.err) {
+			t.Errorf("Expected DeleteAt(%v, %d) to be %v (%v), got %v (%v)", test.s, test.i, test.expected, test.err, s, err)
+		}
+	}
+
+	if err := DeleteAt[int](nil, 0); err != ErrNilSlice {
+		t.Errorf("Expected DeleteAt(nil, 0) to return ErrNilSlice, got %v", err)
+	}
+}
+
+// TestDeleteRange tests the DeleteRange and DeleteUnordered functions
+func TestDeleteRange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		i        int
+		j        int
+		expected []int
+		err      error
+	}{
+		{[]int{1, 2, 3, 4}, 1, 3, []int{1, 4}, nil},
+		{[]int{1, 2, 3, 4}, 0, 4, []int{}, nil},
+		{[]int{1, 2, 3, 4}, 2, 2, []int{1, 2, 3, 4}, nil},
+		{[]int{1, 2, 3, 4}, 3, 5, []int{1, 2, 3, 4}, ErrIndexOutOfRange},
+		{[]int{1, 2, 3, 4}, 3, 1, []int{1, 2, 3, 4}, ErrIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		s := slices.Clone(test.s)
+		err := DeleteRange(&s, test.i, test.j)
+		if !EqSlices(&s, &test.expected) || !errors.Is(err, test.err) {
+			t.Errorf("Expected DeleteRange(%v, %d, %d) to be %v (%v), got %v (%v)", test.s, test.i, test.j, test.expected, test.err, s, err)
+		}
+	}
+
+	s := []int{1, 2, 3, 4}
+	if err := DeleteUnordered(&s, 0); err != nil || !EqSlices(&s, &[]int{4, 2, 3}) {
+		t.Errorf("Expected DeleteUnordered([1 2 3 4], 0) to be [4 2 3], got %v (%v)", s, err)
+	}
+	if err := DeleteUnordered(&s, 3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected DeleteUnordered(%v, 3) to return ErrIndexOutOfRange, got %v", s, err)
+	}
+}
+
+// TestReleasedSlotsZeroed tests that the deleting functions don't keep references to the removed items
+func TestReleasedSlotsZeroed(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := new(int), new(int), new(int)
+
+	var tests = []struct {
+		name string
+		f    func(*[]*int)
+	}{
+		{"Delete", func(s *[]*int) { _ = DeleteAt(s, 0) }},
+		{"DeleteRange", func(s *[]*int) { _ = DeleteRange(s, 0, 2) }},
+		{"DeleteUnordered", func(s *[]*int) { _ = DeleteUnordered(s, 0) }},
+		{"RemoveIf", func(s *[]*int) { RemoveIf(s, func(p *int) bool { return p != c }) }},
+		{"Compact", func(s *[]*int) { *s = append((*s)[:1], a, a); Compact(s) }},
+	}
+
+	for _, test := range tests {
+		s := []*int{a, b, c}
+		full := s[:cap(s)]
+		test.f(&s)
+
+		for i := len(s); i < len(full); i++ {
+			if full[i] != nil {
+				t.Errorf("Expected %s to zero the released slot %d, got %v", test.name, i, full[i])
+			}
+		}
+	}
+
+	legacy := []string{"foo", "bar", "baz"}
+	full := legacy[:cap(legacy)]
+	Delete(&legacy, 0)
+	if full[2] != "" {
+		t.Errorf("Expected Delete to zero the released slot, got %q", full[2])
+	}
+}
+
+// TestRemoveIf tests the RemoveIf function
+func TestRemoveIf(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		expected []int
+		removed  int
+	}{
+		{[]int{1, 2, 3, 4, 5}, []int{1, 3, 5}, 2},
+		{[]int{2, 4}, []int{}, 2},
+		{[]int{1, 3}, []int{1, 3}, 0},
+		{[]int{}, []int{}, 0},
+	}
+
+	for _, test := range tests {
+		s := slices.Clone(test.s)
+		removed := RemoveIf(&s, func(i int) bool {
+			return i%2 == 0
+		})
+		if !EqSlices(&s, &test.expected) || removed != test.removed {
+			t.Errorf("Expected RemoveIf(%v, fn) to be %v (%d), got %v (%d)", test.s, test.expected, test.removed, s, removed)
+		}
+	}
+}
+
+// TestInsertAt tests the InsertAt function
+func TestInsertAt(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []string
+		i        int
+		v        []string
+		expected []string
+		err      error
+	}{
+		{[]string{"foo", "baz"}, 1, []string{"bar"}, []string{"foo", "bar", "baz"}, nil},
+		{[]string{"foo"}, 0, []string{"bar", "baz"}, []string{"bar", "baz", "foo"}, nil},
+		{[]string{"foo"}, 1, []string{"bar"}, []string{"foo", "bar"}, nil},
+		{[]string{"foo"}, 2, []string{"bar"}, []string{"foo"}, ErrIndexOutOfRange},
+		{[]string{}, -1, []string{"bar"}, []string{}, ErrIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		s := slices.Clone(test.s)
+		err := InsertAt(&s, test.i, test.v...)
+		if !EqSlices(&s, &test.expected) || !errors.Is(err, test.err) {
+			t.Errorf("Expected InsertAt(%q, %d, %q) to be %q (%v), got %q (%v)", test.s, test.i, test.v, test.expected, test.err, s, err)
+		}
+	}
+}
+
+// TestMove tests the Move function
+func TestMove(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []int
+		from     int
+		to       int
+		expected []int
+		err      error
+	}{
+		{[]int{1, 2, 3, 4}, 0, 2, []int{2, 3, 1, 4}, nil},
+		{[]int{1, 2, 3, 4}, 3, 1, []int{1, 4, 2, 3}, nil},
+		{[]int{1, 2, 3, 4}, 2, 2, []int{1, 2, 3, 4}, nil},
+		{[]int{1, 2, 3, 4}, 0, 4, []int{1, 2, 3, 4}, ErrIndexOutOfRange},
+	}
+
+	for _, test := range tests {
+		s := slices.Clone(test.s)
+		err := Move(s, test.from, test.to)
+		if !EqSlices(&s, &test.expected) || !errors.Is(err, test.err) {
+			t.Errorf("Expected Move(%v, %d, %d) to be %v (%v), got %v (%v)", test.s, test.from, test.to, test.expected, test.err, s, err)
+		}
+	}
+}
+
+// TestCompact tests the Compact function
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []string
+		expected []string
+	}{
+		{[]string{"foo", "foo", "bar", "foo", "foo"}, []string{"foo", "bar", "foo"}},
+		{[]string{"foo", "bar"}, []string{"foo", "bar"}},
+		{[]string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		s := slices.Clone(test.s)
+		Compact(&s)
+		if !EqSlices(&s, &test.expected) {
+			t.Errorf("Expected Compact(%q) to be %q, got %q", test.s, test.expected, s)
+		}
+	}
+}
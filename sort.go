@@ -0,0 +1,66 @@
+package gosc
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Comparator compares two items, returning a negative number if a comes before b,
+// a positive number if a comes after b and 0 if their order doesn't matter.
+type Comparator[T any] func(a, b T) int
+
+// Asc returns a Comparator sorting ordered items in ascending order
+func Asc[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// By returns a Comparator sorting the items in ascending order by the key returned by the function f
+func By[T any, K cmp.Ordered](f func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(f(a), f(b))
+	}
+}
+
+// ThenBy returns a Comparator using next to order the items that c considers equal
+func (c Comparator[T]) ThenBy(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Desc returns a Comparator reversing the order of c
+func (c Comparator[T]) Desc() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// NullsLast returns a Comparator placing the items for which isNull returns true after all the others,
+// whatever the order of c. Null items are considered equal to each other.
+func (c Comparator[T]) NullsLast(isNull func(T) bool) Comparator[T] {
+	return func(a, b T) int {
+		an, bn := isNull(a), isNull(b)
+		switch {
+		case an && bn:
+			return 0
+		case an:
+			return 1
+		case bn:
+			return -1
+		}
+		return c(a, b)
+	}
+}
+
+// SortWith sorts the slice in place with the given Comparator. The sort is stable.
+func SortWith[T any](s []T, c Comparator[T]) {
+	slices.SortStableFunc(s, c)
+}
+
+// SortBy sorts the slice in place in ascending order by the key returned by the function f. The sort is stable.
+func SortBy[T any, K cmp.Ordered](s []T, f func(T) K) {
+	SortWith(s, By(f))
+}
@@ -0,0 +1,93 @@
+package gosc
+
+import (
+	"reflect"
+	"testing"
+)
+
+type sortUser struct {
+	name  string
+	age   int
+	email *string
+}
+
+// TestSortBy tests the SortBy function
+func TestSortBy(t *testing.T) {
+	t.Parallel()
+
+	s := []sortUser{{"foo", 30, nil}, {"bar", 20, nil}, {"baz", 30, nil}, {"dog", 10, nil}}
+	expected := []sortUser{{"dog", 10, nil}, {"bar", 20, nil}, {"foo", 30, nil}, {"baz", 30, nil}}
+
+	SortBy(s, func(u sortUser) int {
+		return u.age
+	})
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected SortBy to be stable and sort by age, got %v", s)
+	}
+}
+
+// TestComparator tests the Comparator builder
+func TestComparator(t *testing.T) {
+	t.Parallel()
+
+	mail := "foo@example.com"
+	other := "bar@example.com"
+
+	byAge := By(func(u sortUser) int { return u.age })
+	byName := By(func(u sortUser) string { return u.name })
+	noEmail := func(u sortUser) bool { return u.email == nil }
+	byEmail := By(func(u sortUser) string { return *u.email })
+
+	users := []sortUser{{"foo", 30, nil}, {"bar", 20, &mail}, {"baz", 30, &other}, {"dog", 20, nil}}
+
+	var tests = []struct {
+		name     string
+		c        Comparator[sortUser]
+		expected []string
+	}{
+		{"age, name", byAge.ThenBy(byName), []string{"bar", "dog", "baz", "foo"}},
+		{"age desc, name", byAge.Desc().ThenBy(byName), []string{"baz", "foo", "bar", "dog"}},
+		{"age desc, name desc", byAge.Desc().ThenBy(byName.Desc()), []string{"foo", "baz", "dog", "bar"}},
+		{"email nulls last", byEmail.NullsLast(noEmail), []string{"baz", "bar", "foo", "dog"}},
+		{"email desc nulls last", byEmail.Desc().NullsLast(noEmail), []string{"bar", "baz", "foo", "dog"}},
+	}
+
+	for _, test := range tests {
+		s := make([]sortUser, len(users))
+		copy(s, users)
+		SortWith(s, test.c)
+
+		actual := Map(s, func(u sortUser) string { return u.name })
+		if !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected SortWith(%s) to be %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+// TestRsort tests the Rsort function with different ordered types
+func TestRsort(t *testing.T) {
+	t.Parallel()
+
+	s1 := []string{"foo", "bar", "lazy", "dog"}
+	s2 := []int{5, -3, 64}
+	s3 := []float32{1.5, -2, 3}
+	s4 := []uint8{2, 9, 4}
+
+	Rsort(&s1)
+	ReverseSort(&s2)
+	Rsort(&s3)
+	Rsort(&s4)
+
+	if expected := []string{"lazy", "foo", "dog", "bar"}; !EqSlices(&s1, &expected) {
+		t.Errorf("Expected Rsort to be %q, got %q", expected, s1)
+	}
+	if expected := []int{64, 5, -3}; !EqSlices(&s2, &expected) {
+		t.Errorf("Expected ReverseSort to be %v, got %v", expected, s2)
+	}
+	if expected := []float32{3, 1.5, -2}; !EqSlices(&s3, &expected) {
+		t.Errorf("Expected Rsort to be %v, got %v", expected, s3)
+	}
+	if expected := []uint8{9, 4, 2}; !EqSlices(&s4, &expected) {
+		t.Errorf("Expected Rsort to be %v, got %v", expected, s4)
+	}
+}
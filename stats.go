@@ -0,0 +1,283 @@
+package gosc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+var (
+	// ErrInvalidQuantile is returned when a quantile is not in [0, 1] or a percentile not in [0, 100]
+	ErrInvalidQuantile = errors.New("gosc: invalid quantile")
+	// ErrInvalidBins is returned when a histogram is requested with less than one bin
+	ErrInvalidBins = errors.New("gosc: number of bins must be positive")
+	// ErrNonFinite is returned when a histogram is requested for NaN or infinite values
+	ErrNonFinite = errors.New("gosc: non-finite value")
+)
+
+// QuantileMethod selects how a quantile falling between two items is interpolated
+type QuantileMethod int
+
+const (
+	// QuantileLinear interpolates linearly between the two items (default)
+	QuantileLinear QuantileMethod = iota
+	// QuantileLower takes the lower item
+	QuantileLower
+	// QuantileHigher takes the higher item
+	QuantileHigher
+	// QuantileNearest takes the nearest item, rounding half to even
+	QuantileNearest
+	// QuantileMidpoint takes the average of the two items
+	QuantileMidpoint
+)
+
+// Sum returns the sum of the items of the slice
+func Sum[N Number](s []N) N {
+	return SumBy(s, identity[N])
+}
+
+// Mean returns the arithmetic mean of the items of the slice, or 0 if the slice is empty
+func Mean[N Number](s []N) float64 {
+	return welfordOf(s).Mean()
+}
+
+// Median returns the median of the items of the slice, or 0 if the slice is empty
+func Median[N Number](s []N) float64 {
+	v, _ := Quantile(s, 0.5)
+	return v
+}
+
+// Mode returns the most frequent items of the slice in ascending order
+func Mode[N Number](s []N) []N {
+	freq := Frequencies(s)
+	best := 0
+	for _, n := range freq {
+		best = max(best, n)
+	}
+
+	modes := []N{}
+	for v, n := range freq {
+		if n == best {
+			modes = append(modes, v)
+		}
+	}
+	slices.Sort(modes)
+
+	return modes
+}
+
+// Variance returns the population variance of the items of the slice, or 0 if the slice is empty
+func Variance[N Number](s []N) float64 {
+	return welfordOf(s).Variance()
+}
+
+// SampleVariance returns the sample variance (n - 1 denominator) of the items of the slice,
+// or 0 if the slice has less than two items
+func SampleVariance[N Number](s []N) float64 {
+	return welfordOf(s).SampleVariance()
+}
+
+// StdDev returns the population standard deviation of the items of the slice, or 0 if the slice is empty
+func StdDev[N Number](s []N) float64 {
+	return welfordOf(s).StdDev()
+}
+
+// SampleStdDev returns the sample standard deviation of the items of the slice,
+// or 0 if the slice has less than two items
+func SampleStdDev[N Number](s []N) float64 {
+	return welfordOf(s).SampleStdDev()
+}
+
+// welfordOf returns a Welford accumulator fed with all the items of the slice
+func welfordOf[N Number](s []N) *Welford {
+	w := &Welford{}
+	for _, v := range s {
+		w.Add(float64(v))
+	}
+
+	return w
+}
+
+// Quantile returns the q-th quantile (0 <= q <= 1) of the items of the slice, or 0 if the slice is empty.
+// The optional method selects the interpolation between two items and defaults to QuantileLinear.
+func Quantile[N Number](s []N, q float64, method ...QuantileMethod) (float64, error) {
+	if !(q >= 0 && q <= 1) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidQuantile, q)
+	}
+	if len(s) == 0 {
+		return 0, nil
+	}
+
+	m := QuantileLinear
+	if len(method) > 0 {
+		m = method[0]
+	}
+
+	sorted := make([]float64, len(s))
+	for i, v := range s {
+		sorted[i] = float64(v)
+	}
+	slices.Sort(sorted)
+
+	h := q * float64(len(sorted)-1)
+	lo, hi := int(math.Floor(h)), int(math.Ceil(h))
+	if lo == hi {
+		// No interpolation, which would turn infinite items into NaN
+		return sorted[lo], nil
+	}
+
+	switch m {
+	case QuantileLower:
+		return sorted[lo], nil
+	case QuantileHigher:
+		return sorted[hi], nil
+	case QuantileNearest:
+		return sorted[int(math.RoundToEven(h))], nil
+	case QuantileMidpoint:
+		return (sorted[lo] + sorted[hi]) / 2, nil
+	default:
+		return sorted[lo] + (h-float64(lo))*(sorted[hi]-sorted[lo]), nil
+	}
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of the items of the slice, or 0 if the slice is empty.
+// The optional method selects the interpolation between two items and defaults to QuantileLinear.
+func Percentile[N Number](s []N, p float64, method ...QuantileMethod) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, fmt.Errorf("%w: percentile %v", ErrInvalidQuantile, p)
+	}
+
+	return Quantile(s, p/100, method...)
+}
+
+// Bin is a histogram bin counting the items in [Low, High), the last bin including High
+type Bin struct {
+	Low   float64
+	High  float64
+	Count int
+}
+
+// Histogram splits the range of the items of the slice into the given number of equal-width bins
+// and counts the items falling into each of them. If all the items are equal, the range is centered on them
+// with a width of 1. An error wrapping ErrInvalidBins is returned if bins is less than 1,
+// and one wrapping ErrNonFinite if the slice contains NaN or infinite values.
+func Histogram[N Number](s []N, bins int) ([]Bin, error) {
+	if bins < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidBins, bins)
+	}
+	for i, v := range s {
+		if f := float64(v); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%w: %v at index %d", ErrNonFinite, f, i)
+		}
+	}
+	if len(s) == 0 {
+		return []Bin{}, nil
+	}
+
+	lo, hi, _ := MinMax(s)
+	low, high := float64(lo), float64(hi)
+	if low == high {
+		low, high = low-0.5, high+0.5
+	}
+
+	// Work on halved values if the range overflows, and never divide by a width that may underflow to 0
+	span, scale := high-low, 1.0
+	if math.IsInf(span, 1) {
+		scale = 0.5
+		span = high*scale - low*scale
+	}
+	bound := func(f float64) float64 {
+		return (low*scale + span*f) / scale
+	}
+
+	hist := make([]Bin, bins)
+	for i := range hist {
+		hist[i].Low = bound(float64(i) / float64(bins))
+		hist[i].High = bound(float64(i+1) / float64(bins))
+	}
+	hist[0].Low, hist[bins-1].High = low, high
+
+	for _, v := range s {
+		f := 0.5
+		if span > 0 {
+			f = (float64(v)*scale - low*scale) / span
+		}
+		i := max(0, min(int(f*float64(bins)), bins-1))
+		hist[i].Count++
+	}
+
+	return hist, nil
+}
+
+// Welford is a streaming accumulator computing the mean and the variance of the values added to it
+// in a single pass, with Welford's online algorithm. The zero value is ready to use.
+type Welford struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+// Add adds a value to the accumulator
+func (w *Welford) Add(x float64) {
+	w.n++
+	delta := x - w.mean
+	w.mean += delta / float64(w.n)
+	w.m2 += delta * (x - w.mean)
+}
+
+// Merge adds all the values of the other accumulator to this one
+func (w *Welford) Merge(o Welford) {
+	if o.n == 0 {
+		return
+	}
+	if w.n == 0 {
+		*w = o
+		return
+	}
+
+	n := w.n + o.n
+	delta := o.mean - w.mean
+	w.m2 += o.m2 + delta*delta*float64(w.n)*float64(o.n)/float64(n)
+	w.mean += delta * float64(o.n) / float64(n)
+	w.n = n
+}
+
+// Count returns the number of values added to the accumulator
+func (w *Welford) Count() int {
+	return w.n
+}
+
+// Mean returns the mean of the values added to the accumulator, or 0 if there are none
+func (w *Welford) Mean() float64 {
+	return w.mean
+}
+
+// Variance returns the population variance of the values added to the accumulator, or 0 if there are none
+func (w *Welford) Variance() float64 {
+	if w.n == 0 {
+		return 0
+	}
+
+	return w.m2 / float64(w.n)
+}
+
+// SampleVariance returns the sample variance of the values added to the accumulator,
+// or 0 if there are less than two
+func (w *Welford) SampleVariance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+
+	return w.m2 / float64(w.n-1)
+}
+
+// StdDev returns the population standard deviation of the values added to the accumulator
+func (w *Welford) StdDev() float64 {
+	return math.Sqrt(w.Variance())
+}
+
+// SampleStdDev returns the sample standard deviation of the values added to the accumulator
+func (w *Welford) SampleStdDev() float64 {
+	return math.Sqrt(w.SampleVariance())
+}
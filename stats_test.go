@@ -0,0 +1,215 @@
+package gosc
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// almostEqual returns true if the two floats are equal up to a small tolerance
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*max(1, math.Abs(a), math.Abs(b))
+}
+
+// TestStats tests the Sum, Mean, Median, Variance and StdDev functions
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s                []int
+		expectedSum      int
+		expectedMean     float64
+		expectedMedian   float64
+		expectedVariance float64
+		expectedSample   float64
+	}{
+		{[]int{2, 4, 4, 4, 5, 5, 7, 9}, 40, 5, 4.5, 4, 32.0 / 7},
+		{[]int{3, 1, 2}, 6, 2, 2, 2.0 / 3, 1},
+		{[]int{7}, 7, 7, 7, 0, 0},
+		{[]int{}, 0, 0, 0, 0, 0},
+	}
+
+	for _, test := range tests {
+		if actual := Sum(test.s); actual != test.expectedSum {
+			t.Errorf("Expected Sum(%v) to be %d, got %d", test.s, test.expectedSum, actual)
+		}
+		if actual := Mean(test.s); !almostEqual(actual, test.expectedMean) {
+			t.Errorf("Expected Mean(%v) to be %v, got %v", test.s, test.expectedMean, actual)
+		}
+		if actual := Median(test.s); !almostEqual(actual, test.expectedMedian) {
+			t.Errorf("Expected Median(%v) to be %v, got %v", test.s, test.expectedMedian, actual)
+		}
+		if actual := Variance(test.s); !almostEqual(actual, test.expectedVariance) {
+			t.Errorf("Expected Variance(%v) to be %v, got %v", test.s, test.expectedVariance, actual)
+		}
+		if actual := StdDev(test.s); !almostEqual(actual, math.Sqrt(test.expectedVariance)) {
+			t.Errorf("Expected StdDev(%v) to be %v, got %v", test.s, math.Sqrt(test.expectedVariance), actual)
+		}
+		if actual := SampleVariance(test.s); !almostEqual(actual, test.expectedSample) {
+			t.Errorf("Expected SampleVariance(%v) to be %v, got %v", test.s, test.expectedSample, actual)
+		}
+	}
+
+	// Large offsets must not lose precision
+	big := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	if actual := Variance(big); !almostEqual(actual, 22.5) {
+		t.Errorf("Expected Variance(%v) to be 22.5, got %v", big, actual)
+	}
+}
+
+// TestMode tests the Mode function
+func TestMode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []float32
+		expected []float32
+	}{
+		{[]float32{1, 2, 2, 3}, []float32{2}},
+		{[]float32{3, 1, 3, 1, 2}, []float32{1, 3}},
+		{[]float32{}, []float32{}},
+	}
+
+	for _, test := range tests {
+		if actual := Mode(test.s); !EqualOrdered(actual, test.expected) {
+			t.Errorf("Expected Mode(%v) to be %v, got %v", test.s, test.expected, actual)
+		}
+	}
+}
+
+// TestQuantile tests the Quantile and Percentile functions
+func TestQuantile(t *testing.T) {
+	t.Parallel()
+
+	s := []uint8{40, 10, 30, 20}
+	var tests = []struct {
+		p        float64
+		method   QuantileMethod
+		expected float64
+	}{
+		{0, QuantileLinear, 10},
+		{100, QuantileLinear, 40},
+		{40, QuantileLinear, 22},
+		{40, QuantileLower, 20},
+		{40, QuantileHigher, 30},
+		{40, QuantileNearest, 20},
+		{50, QuantileNearest, 30},
+		{40, QuantileMidpoint, 25},
+	}
+
+	for _, test := range tests {
+		actual, err := Percentile(s, test.p, test.method)
+		if err != nil || !almostEqual(actual, test.expected) {
+			t.Errorf("Expected Percentile(%v, %v, %d) to be %v, got %v (%v)", s, test.p, test.method, test.expected, actual, err)
+		}
+		actual, err = Quantile(s, test.p/100, test.method)
+		if err != nil || !almostEqual(actual, test.expected) {
+			t.Errorf("Expected Quantile(%v, %v, %d) to be %v, got %v (%v)", s, test.p/100, test.method, test.expected, actual, err)
+		}
+	}
+
+	inf := []float64{1, math.Inf(1), math.Inf(1)}
+	for _, method := range []QuantileMethod{QuantileLinear, QuantileMidpoint} {
+		if actual, _ := Quantile(inf, 0.5, method); !math.IsInf(actual, 1) {
+			t.Errorf("Expected Quantile(%v, 0.5, %d) to be +Inf, got %v", inf, method, actual)
+		}
+	}
+	if actual := Median([]float64{math.Inf(1)}); !math.IsInf(actual, 1) {
+		t.Errorf("Expected Median([+Inf]) to be +Inf, got %v", actual)
+	}
+
+	for _, p := range []float64{-1, 101, math.NaN()} {
+		if _, err := Percentile(s, p); !errors.Is(err, ErrInvalidQuantile) {
+			t.Errorf("Expected Percentile(%v, %v) to return ErrInvalidQuantile, got %v", s, p, err)
+		}
+	}
+}
+
+// TestHistogram tests the Histogram function
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        []float64
+		bins     int
+		expected []Bin
+	}{
+		{[]float64{0, 1, 2, 3, 4, 10}, 2, []Bin{{0, 5, 5}, {5, 10, 1}}},
+		{[]float64{1, 2, 2, 3}, 4, []Bin{{1, 1.5, 1}, {1.5, 2, 0}, {2, 2.5, 2}, {2.5, 3, 1}}},
+		{[]float64{3, 3}, 1, []Bin{{2.5, 3.5, 2}}},
+		{[]float64{}, 3, []Bin{}},
+	}
+
+	for _, test := range tests {
+		actual, err := Histogram(test.s, test.bins)
+		if err != nil || !EqualOrdered(actual, test.expected) {
+			t.Errorf("Expected Histogram(%v, %d) to be %v, got %v (%v)", test.s, test.bins, test.expected, actual, err)
+		}
+	}
+
+	// Ranges too small or too large for a float64 width
+	var extremes = []struct {
+		s        []float64
+		bins     int
+		expected []int
+	}{
+		{[]float64{0, 5e-324}, 2, []int{1, 1}},
+		{[]float64{-math.MaxFloat64, math.MaxFloat64}, 3, []int{1, 0, 1}},
+		{[]float64{math.MaxFloat64, math.MaxFloat64}, 2, []int{0, 2}},
+	}
+
+	for _, test := range extremes {
+		actual, err := Histogram(test.s, test.bins)
+		counts := Map(actual, func(b Bin) int { return b.Count })
+		if err != nil || !EqualOrdered(counts, test.expected) {
+			t.Errorf("Expected Histogram(%v, %d) counts to be %v, got %v (%v)", test.s, test.bins, test.expected, actual, err)
+		}
+		for _, b := range actual {
+			if math.IsNaN(b.Low) || math.IsInf(b.Low, 0) || math.IsNaN(b.High) || math.IsInf(b.High, 0) || b.Low > b.High {
+				t.Errorf("Expected Histogram(%v, %d) to have finite bins, got %v", test.s, test.bins, actual)
+				break
+			}
+		}
+	}
+
+	if _, err := Histogram([]int{1}, 0); !errors.Is(err, ErrInvalidBins) {
+		t.Errorf("Expected Histogram([1], 0) to return ErrInvalidBins, got %v", err)
+	}
+	for _, s := range [][]float64{{1, math.NaN(), 3}, {1, math.Inf(1)}, {math.Inf(-1), 1}} {
+		if _, err := Histogram(s, 2); !errors.Is(err, ErrNonFinite) {
+			t.Errorf("Expected Histogram(%v, 2) to return ErrNonFinite, got %v", s, err)
+		}
+	}
+}
+
+// TestWelford tests the Welford accumulator
+func TestWelford(t *testing.T) {
+	t.Parallel()
+
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	var all, left, right Welford
+	for i, v := range values {
+		all.Add(v)
+		if i < 3 {
+			left.Add(v)
+		} else {
+			right.Add(v)
+		}
+	}
+	left.Merge(right)
+
+	for _, w := range []Welford{all, left} {
+		if w.Count() != 8 || !almostEqual(w.Mean(), 5) || !almostEqual(w.Variance(), 4) || !almostEqual(w.StdDev(), 2) {
+			t.Errorf("Expected Welford to have count 8, mean 5, variance 4 and stddev 2, got %d %v %v %v", w.Count(), w.Mean(), w.Variance(), w.StdDev())
+		}
+		if !almostEqual(w.SampleVariance(), 32.0/7) {
+			t.Errorf("Expected Welford sample variance to be %v, got %v", 32.0/7, w.SampleVariance())
+		}
+	}
+
+	var empty Welford
+	if empty.Mean() != 0 || empty.Variance() != 0 || empty.SampleStdDev() != 0 {
+		t.Errorf("Expected an empty Welford to return zeros")
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math/rand"
 	"net"
 	"net/url"
 	"regexp"
@@ -408,12 +407,7 @@ func Unique() string {
 
 // StrRand returns a random string
 func StrRand(n int) string {
-	rand.Seed(time.Now().Unix())
-	b := make([]rune, n)
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
-	}
-	return string(b)
+	return defaultRandom.String(n)
 }
 
 // RandStr is an alias of StrRand
@@ -0,0 +1,111 @@
+package gosc
+
+// ErrorMode sets how the error-aware helpers handle the errors of the callbacks
+type ErrorMode int
+
+const (
+	// FailFast stops at the first error (default)
+	FailFast ErrorMode = iota
+	// CollectErrors calls the function for every item and returns all the errors
+	CollectErrors
+)
+
+// collectErrors returns true if the optional mode is CollectErrors
+func collectErrors(mode []ErrorMode) bool {
+	return len(mode) > 0 && mode[0] == CollectErrors
+}
+
+// MapE is like Map but the function f can fail. On error a nil slice is returned, with the errors
+// joined with errors.Join as *IndexError; by default it stops at the first one, see ErrorMode.
+func MapE[T, U any](s []T, f func(T) (U, error), mode ...ErrorMode) ([]U, error) {
+	sm := make([]U, len(s))
+	var errs []*IndexError
+	for i, v := range s {
+		u, err := f(v)
+		if err != nil {
+			errs = append(errs, &IndexError{i, err})
+			if !collectErrors(mode) {
+				break
+			}
+			continue
+		}
+		sm[i] = u
+	}
+
+	if len(errs) > 0 {
+		return nil, joinIndexErrors(errs)
+	}
+
+	return sm, nil
+}
+
+// FilterE is like Filter but the predicate f can fail. The errors are handled like MapE.
+func FilterE[T any](s []T, f func(T) (bool, error), mode ...ErrorMode) ([]T, error) {
+	keep, err := MapE(s, f, mode...)
+	if err != nil {
+		return nil, err
+	}
+
+	sf := make([]T, 0)
+	for i, v := range s {
+		if keep[i] {
+			sf = append(sf, v)
+		}
+	}
+
+	return sf, nil
+}
+
+// ForEachE calls the function f for each item of the slice. The errors are handled like MapE.
+func ForEachE[T any](s []T, f func(T) error, mode ...ErrorMode) error {
+	_, err := MapE(s, func(v T) (struct{}, error) {
+		return struct{}{}, f(v)
+	}, mode...)
+
+	return err
+}
+
+// ReduceE is like Reduce but the function f can fail. It stops at the first error, returning
+// the accumulator computed until the previous item and the error as *IndexError.
+func ReduceE[T, U any](s []T, f func(U, T) (U, error), init U) (U, error) {
+	acc := init
+	for i, v := range s {
+		next, err := f(acc, v)
+		if err != nil {
+			return acc, &IndexError{i, err}
+		}
+		acc = next
+	}
+
+	return acc, nil
+}
+
+// TryAll calls all the given functions and returns their errors joined with errors.Join as *IndexError,
+// where the index is the position of the function
+func TryAll(fs ...func() error) error {
+	return ForEachE(fs, func(f func() error) error {
+		return f()
+	}, CollectErrors)
+}
+
+// FailedIndexes returns the indexes recorded by the *IndexError found in the error tree, in order
+func FailedIndexes(err error) []int {
+	indexes := make([]int, 0)
+
+	var walk func(error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case *IndexError:
+			indexes = append(indexes, e.Index)
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				walk(inner)
+			}
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		}
+	}
+	walk(err)
+
+	return indexes
+}
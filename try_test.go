@@ -0,0 +1,127 @@
+package gosc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+// TestMapE tests the MapE function with both error modes
+func TestMapE(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s               []string
+		mode            ErrorMode
+		expected        []int
+		expectedIndexes []int
+	}{
+		{[]string{"1", "2", "3"}, FailFast, []int{1, 2, 3}, []int{}},
+		{[]string{"1", "foo", "3", "bar"}, FailFast, nil, []int{1}},
+		{[]string{"1", "foo", "3", "bar"}, CollectErrors, nil, []int{1, 3}},
+		{[]string{}, CollectErrors, []int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		actual, err := MapE(test.s, strconv.Atoi, test.mode)
+		if !EqSlices(&actual, &test.expected) || (actual == nil) != (test.expected == nil) {
+			t.Errorf("Expected MapE(%q, Atoi, %d) to be %v, got %v", test.s, test.mode, test.expected, actual)
+		}
+
+		indexes := FailedIndexes(err)
+		if !EqualOrdered(indexes, test.expectedIndexes) {
+			t.Errorf("Expected MapE(%q, Atoi, %d) to fail at %v, got %v (%v)", test.s, test.mode, test.expectedIndexes, indexes, err)
+		}
+
+		var numErr *strconv.NumError
+		if len(test.expectedIndexes) > 0 && !errors.As(err, &numErr) {
+			t.Errorf("Expected MapE(%q, Atoi, %d) errors to wrap *strconv.NumError, got %v", test.s, test.mode, err)
+		}
+	}
+}
+
+// TestFilterForEachE tests the FilterE and ForEachE functions
+func TestFilterForEachE(t *testing.T) {
+	t.Parallel()
+
+	positive := func(s string) (bool, error) {
+		i, err := strconv.Atoi(s)
+		return i > 0, err
+	}
+
+	s := []string{"1", "-2", "3"}
+	actual, err := FilterE(s, positive)
+	if expected := []string{"1", "3"}; err != nil || !EqualOrdered(actual, expected) {
+		t.Errorf("Expected FilterE(%q, fn) to be %q, got %q (%v)", s, expected, actual, err)
+	}
+
+	s = []string{"1", "foo", "bar"}
+	if _, err := FilterE(s, positive, CollectErrors); !EqualOrdered(FailedIndexes(err), []int{1, 2}) {
+		t.Errorf("Expected FilterE(%q, fn, CollectErrors) to fail at [1 2], got %v", s, err)
+	}
+
+	calls := 0
+	err = ForEachE([]int{1, 2, 3}, func(i int) error {
+		calls++
+		if i == 2 {
+			return fmt.Errorf("invalid %d", i)
+		}
+		return nil
+	})
+	if calls != 2 || err == nil || err.Error() != "index 1: invalid 2" {
+		t.Errorf("Expected ForEachE to stop at the first error after 2 calls, got %d calls (%v)", calls, err)
+	}
+}
+
+// TestReduceE tests the ReduceE function
+func TestReduceE(t *testing.T) {
+	t.Parallel()
+
+	sum := func(acc int, s string) (int, error) {
+		i, err := strconv.Atoi(s)
+		return acc + i, err
+	}
+
+	var tests = []struct {
+		s               []string
+		expected        int
+		expectedIndexes []int
+	}{
+		{[]string{"1", "2", "3"}, 6, []int{}},
+		{[]string{"1", "2", "foo", "3"}, 3, []int{2}},
+		{[]string{}, 0, []int{}},
+	}
+
+	for _, test := range tests {
+		actual, err := ReduceE(test.s, sum, 0)
+		if actual != test.expected || !EqualOrdered(FailedIndexes(err), test.expectedIndexes) {
+			t.Errorf("Expected ReduceE(%q, fn, 0) to be %d failing at %v, got %d (%v)", test.s, test.expected, test.expectedIndexes, actual, err)
+		}
+	}
+}
+
+// TestTryAll tests the TryAll function
+func TestTryAll(t *testing.T) {
+	t.Parallel()
+
+	errFoo := errors.New("foo")
+	errBar := errors.New("bar")
+
+	calls := 0
+	err := TryAll(
+		func() error { calls++; return errFoo },
+		func() error { calls++; return nil },
+		func() error { calls++; return errBar },
+	)
+
+	if calls != 3 || !errors.Is(err, errFoo) || !errors.Is(err, errBar) {
+		t.Errorf("Expected TryAll to call all the functions and join the errors, got %d calls (%v)", calls, err)
+	}
+	if indexes := FailedIndexes(err); !EqualOrdered(indexes, []int{0, 2}) {
+		t.Errorf("Expected TryAll to fail at [0 2], got %v", indexes)
+	}
+	if err := TryAll(func() error { return nil }); err != nil {
+		t.Errorf("Expected TryAll to return nil, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package gosc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrRaggedMatrix is returned when the rows of a matrix don't have the same length
+var ErrRaggedMatrix = errors.New("gosc: rows have different lengths")
+
+// Pair is a couple of values of any type
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a group of three values of any type
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip returns a slice of pairs made by the items of the slices at the same index.
+// The result is as long as the shortest slice.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	sz := make([]Pair[A, B], min(len(a), len(b)))
+	for i := range sz {
+		sz[i] = Pair[A, B]{a[i], b[i]}
+	}
+
+	return sz
+}
+
+// ZipLongest is like Zip but the result is as long as the longest slice,
+// using the fill values in place of the missing items.
+func ZipLongest[A, B any](a []A, b []B, fillA A, fillB B) []Pair[A, B] {
+	sz := make([]Pair[A, B], max(len(a), len(b)))
+	for i := range sz {
+		sz[i] = Pair[A, B]{fillA, fillB}
+		if i < len(a) {
+			sz[i].First = a[i]
+		}
+		if i < len(b) {
+			sz[i].Second = b[i]
+		}
+	}
+
+	return sz
+}
+
+// Unzip splits a slice of pairs into two slices
+func Unzip[A, B any](s []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(s))
+	b := make([]B, len(s))
+	for i, p := range s {
+		a[i], b[i] = p.First, p.Second
+	}
+
+	return a, b
+}
+
+// Zip3 returns a slice of triples made by the items of the slices at the same index.
+// The result is as long as the shortest slice.
+func Zip3[A, B, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	sz := make([]Triple[A, B, C], min(len(a), len(b), len(c)))
+	for i := range sz {
+		sz[i] = Triple[A, B, C]{a[i], b[i], c[i]}
+	}
+
+	return sz
+}
+
+// Unzip3 splits a slice of triples into three slices
+func Unzip3[A, B, C any](s []Triple[A, B, C]) ([]A, []B, []C) {
+	a := make([]A, len(s))
+	b := make([]B, len(s))
+	c := make([]C, len(s))
+	for i, t := range s {
+		a[i], b[i], c[i] = t.First, t.Second, t.Third
+	}
+
+	return a, b, c
+}
+
+// Flatten returns a new slice containing the items of all the nested slices
+func Flatten[T any](s [][]T) []T {
+	n := 0
+	for _, v := range s {
+		n += len(v)
+	}
+
+	sf := make([]T, 0, n)
+	for _, v := range s {
+		sf = append(sf, v...)
+	}
+
+	return sf
+}
+
+// FlatMap applies the function f to each item of the slice and flattens the results in a new slice
+func FlatMap[T, U any](s []T, f func(T) []U) []U {
+	sf := make([]U, 0, len(s))
+	for _, v := range s {
+		sf = append(sf, f(v)...)
+	}
+
+	return sf
+}
+
+// Transpose returns a new matrix with rows and columns swapped.
+// An error wrapping ErrRaggedMatrix is returned if the rows don't have the same length.
+func Transpose[T any](m [][]T) ([][]T, error) {
+	if len(m) == 0 {
+		return [][]T{}, nil
+	}
+
+	cols := len(m[0])
+	for i, row := range m {
+		if len(row) != cols {
+			return nil, fmt.Errorf("%w: row %d has %d items, expected %d", ErrRaggedMatrix, i, len(row), cols)
+		}
+	}
+
+	mt := make([][]T, cols)
+	for j := range mt {
+		mt[j] = make([]T, len(m))
+		for i, row := range m {
+			mt[j][i] = row[j]
+		}
+	}
+
+	return mt, nil
+}
@@ -0,0 +1,127 @@
+package gosc
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestZip tests the Zip and Unzip functions
+func TestZip(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a        []string
+		b        []int
+		expected []Pair[string, int]
+	}{
+		{[]string{"foo", "bar"}, []int{1, 2}, []Pair[string, int]{{"foo", 1}, {"bar", 2}}},
+		{[]string{"foo", "bar", "baz"}, []int{1}, []Pair[string, int]{{"foo", 1}}},
+		{[]string{}, []int{1}, []Pair[string, int]{}},
+	}
+
+	for _, test := range tests {
+		actual := Zip(test.a, test.b)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected Zip(%q, %v) to be %v, got %v", test.a, test.b, test.expected, actual)
+		}
+
+		a, b := Unzip(actual)
+		n := len(test.expected)
+		if !reflect.DeepEqual(a, test.a[:n]) || !reflect.DeepEqual(b, test.b[:n]) {
+			t.Errorf("Expected Unzip(%v) to be %q %v, got %q %v", actual, test.a[:n], test.b[:n], a, b)
+		}
+	}
+}
+
+// TestZipLongest tests the ZipLongest function
+func TestZipLongest(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		a        []string
+		b        []int
+		expected []Pair[string, int]
+	}{
+		{[]string{"foo", "bar", "baz"}, []int{1}, []Pair[string, int]{{"foo", 1}, {"bar", -1}, {"baz", -1}}},
+		{[]string{"foo"}, []int{1, 2}, []Pair[string, int]{{"foo", 1}, {"?", 2}}},
+		{[]string{}, []int{}, []Pair[string, int]{}},
+	}
+
+	for _, test := range tests {
+		actual := ZipLongest(test.a, test.b, "?", -1)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected ZipLongest(%q, %v, ?, -1) to be %v, got %v", test.a, test.b, test.expected, actual)
+		}
+	}
+}
+
+// TestZip3 tests the Zip3 and Unzip3 functions
+func TestZip3(t *testing.T) {
+	t.Parallel()
+
+	a := []string{"foo", "bar"}
+	b := []int{1, 2, 3}
+	c := []bool{true, false}
+
+	expected := []Triple[string, int, bool]{{"foo", 1, true}, {"bar", 2, false}}
+	actual := Zip3(a, b, c)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected Zip3(%q, %v, %v) to be %v, got %v", a, b, c, expected, actual)
+	}
+
+	ua, ub, uc := Unzip3(actual)
+	if !reflect.DeepEqual(ua, a) || !reflect.DeepEqual(ub, b[:2]) || !reflect.DeepEqual(uc, c) {
+		t.Errorf("Expected Unzip3(%v) to be %q %v %v, got %q %v %v", actual, a, b[:2], c, ua, ub, uc)
+	}
+}
+
+// TestFlatten tests the Flatten and FlatMap functions
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		s        [][]int
+		expected []int
+	}{
+		{[][]int{{1, 2}, {3}, {}, {4, 5}}, []int{1, 2, 3, 4, 5}},
+		{[][]int{{}}, []int{}},
+		{[][]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		if actual := Flatten(test.s); !EqSlices(&actual, &test.expected) {
+			t.Errorf("Expected Flatten(%v) to be %v, got %v", test.s, test.expected, actual)
+		}
+	}
+
+	s := []string{"foo bar", "baz"}
+	expected := []string{"foo", "bar", "baz"}
+	if actual := FlatMap(s, strings.Fields); !EqSlices(&actual, &expected) {
+		t.Errorf("Expected FlatMap(%q, fn) to be %q, got %q", s, expected, actual)
+	}
+}
+
+// TestTranspose tests the Transpose function
+func TestTranspose(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		m        [][]int
+		expected [][]int
+		err      error
+	}{
+		{[][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{1, 4}, {2, 5}, {3, 6}}, nil},
+		{[][]int{{1}}, [][]int{{1}}, nil},
+		{[][]int{}, [][]int{}, nil},
+		{[][]int{{1, 2}, {3}}, nil, ErrRaggedMatrix},
+	}
+
+	for _, test := range tests {
+		actual, err := Transpose(test.m)
+		if !reflect.DeepEqual(actual, test.expected) || !errors.Is(err, test.err) {
+			t.Errorf("Expected Transpose(%v) to be %v (%v), got %v (%v)", test.m, test.expected, test.err, actual, err)
+		}
+	}
+}